@@ -13,6 +13,7 @@ import {
     NodeHandleBounds,
     Handle,
     NodeDataArray,
+    RetryPolicy,
  } from 'proto/workflow/workflow_pb';
  import { Credential, Token } from 'proto/login/login_pb';
  import type { Node as vfNode } from '@vue-flow/core'
@@ -160,6 +161,27 @@ export function nodeDataArrayFromObject(obj: any): NodeDataArray {
     return msg;
   }
 
+export function retryPolicyFromObject(obj: any): RetryPolicy {
+    const msg = new RetryPolicy();
+    const normalizedObj = normalizeObject(obj);
+    if (normalizedObj.maxattempts !== undefined) {
+      msg.setMaxattempts(normalizedObj.maxattempts);
+    }
+    if (normalizedObj.initialdelayms !== undefined) {
+      msg.setInitialdelayms(normalizedObj.initialdelayms);
+    }
+    if (normalizedObj.multiplier !== undefined) {
+      msg.setMultiplier(normalizedObj.multiplier);
+    }
+    if (normalizedObj.maxdelayms !== undefined) {
+      msg.setMaxdelayms(normalizedObj.maxdelayms);
+    }
+    if (normalizedObj.retryonlist !== undefined) {
+      msg.setRetryonList(normalizedObj.retryonlist);
+    }
+    return msg;
+  }
+
 export function nodeDataFromObject(obj: any): NodeData {
     const msg = new NodeData();
     const normalizedObj = normalizeObject(obj);
@@ -232,6 +254,45 @@ export function nodeDataFromObject(obj: any): NodeData {
       const nodeDataArray = nodeDataArrayFromObject(normalizedObj.weeks);
       msg.setWeeks(nodeDataArray);
     }
+    if (normalizedObj.parallel !== undefined) {
+      msg.setParallel(normalizedObj.parallel);
+    }
+    if (normalizedObj.concurrency !== undefined) {
+      msg.setConcurrency(normalizedObj.concurrency);
+    }
+    if (normalizedObj.mode !== undefined) {
+      msg.setMode(normalizedObj.mode);
+    }
+    if (normalizedObj.required !== undefined) {
+      msg.setRequired(normalizedObj.required);
+    }
+    if (normalizedObj.mergeas !== undefined) {
+      msg.setMergeas(normalizedObj.mergeas);
+    }
+    if (normalizedObj.retry !== undefined) {
+      msg.setRetry(retryPolicyFromObject(normalizedObj.retry));
+    }
+    if (normalizedObj.timeoutms !== undefined) {
+      msg.setTimeoutms(normalizedObj.timeoutms);
+    }
+    if (normalizedObj.approvers !== undefined) {
+      msg.setApprovers(nodeDataArrayFromObject(normalizedObj.approvers));
+    }
+    if (normalizedObj.cases !== undefined) {
+      msg.setCases(nodeDataArrayFromObject(normalizedObj.cases));
+    }
+    if (normalizedObj.inputs !== undefined) {
+      msg.setInputs(nodeDataArrayFromObject(normalizedObj.inputs));
+    }
+    if (normalizedObj.outputs !== undefined) {
+      msg.setOutputs(nodeDataArrayFromObject(normalizedObj.outputs));
+    }
+    if (normalizedObj.plaintext !== undefined) {
+      msg.setPlaintext(normalizedObj.plaintext);
+    }
+    if (normalizedObj.parallelitems !== undefined) {
+      msg.setParallelitems(normalizedObj.parallelitems);
+    }
     return msg;
   }
 
@@ -389,6 +450,9 @@ export function workflowFromObject(obj: any): Workflow {
     if (normalizedObj.isnew !== undefined) {
       msg.setIsnew(normalizedObj.isnew);
     }
+    if (normalizedObj.timeoutms !== undefined) {
+      msg.setTimeoutms(normalizedObj.timeoutms);
+    }
     return msg;
   }
 
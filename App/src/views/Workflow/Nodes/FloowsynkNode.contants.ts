@@ -63,7 +63,7 @@ export const nodes: Node.AsObject[] = [
             value: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'condition', label: 'Condition', position: { x: 0, y: 0 },
@@ -76,7 +76,7 @@ export const nodes: Node.AsObject[] = [
             expression: ""
         },
         inputsList: ["input"],
-        outputsList: ["True", "False"]
+        outputsList: ["True", "False", "error"]
     },
     {
         id: '', nodetype: 'loop', label: 'Loop', position: { x: 0, y: 0 },
@@ -89,7 +89,7 @@ export const nodes: Node.AsObject[] = [
             iteration: 1
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["body", "done", "error"]
     },
     {
         id: '', nodetype: 'foreach', label: 'For Each', position: { x: 0, y: 0 },
@@ -99,10 +99,12 @@ export const nodes: Node.AsObject[] = [
             color: "linear-gradient(135deg, var(--blue-1), var(--blue-8))" // blue
         },
         data: {
-            listvar: ""
+            listvar: "",
+            parallelitems: false,
+            concurrency: 0
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["body", "done", "error"]
     },
     {
         id: '', nodetype: 'while', label: 'While', position: { x: 0, y: 0 },
@@ -116,7 +118,95 @@ export const nodes: Node.AsObject[] = [
             limit: 1000
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["body", "done", "error"]
+    },
+    {
+        id: '', nodetype: 'switch', label: 'Switch', position: { x: 0, y: 0 },
+        groupList: [2],
+        icon: {
+            name: "alt_route",
+            color: "linear-gradient(135deg, var(--red-1), var(--red-8))" // red
+        },
+        data: {
+            expression: "",
+            cases: {
+                type: ArrayDataType.STRING,
+                stringitemsList: []
+            }
+        },
+        inputsList: ["input"],
+        // One handle per case is added by the node, ahead of these
+        outputsList: ["default", "error"]
+    },
+    {
+        id: '', nodetype: 'break', label: 'Break', position: { x: 0, y: 0 },
+        groupList: [2],
+        icon: {
+            name: "block",
+            color: "linear-gradient(135deg, var(--blue-1), var(--blue-8))" // blue
+        },
+        inputsList: ["input"],
+        outputsList: []
+    },
+    {
+        id: '', nodetype: 'continue', label: 'Continue', position: { x: 0, y: 0 },
+        groupList: [2],
+        icon: {
+            name: "skip_next",
+            color: "linear-gradient(135deg, var(--blue-1), var(--blue-8))" // blue
+        },
+        inputsList: ["input"],
+        outputsList: []
+    },
+    {
+        id: '', nodetype: 'join', label: 'Join', position: { x: 0, y: 0 },
+        groupList: [2],
+        icon: {
+            name: "merge",
+            color: "linear-gradient(135deg, var(--blue-1), var(--blue-8))" // blue
+        },
+        data: {
+            mode: "all",
+            required: 0,
+            mergeas: "list",
+            variable: ""
+        },
+        inputsList: ["input"],
+        outputsList: ["output", "error"]
+    },
+    {
+        id: '', nodetype: 'delay', label: 'Delay', position: { x: 0, y: 0 },
+        groupList: [2],
+        icon: {
+            name: "hourglass_empty",
+            color: "linear-gradient(135deg, var(--blue-1), var(--blue-8))" // blue
+        },
+        data: {
+            mode: "duration",
+            type: "seconds",
+            interval: 1,
+            value: ""
+        },
+        inputsList: ["input"],
+        outputsList: ["output", "error"]
+    },
+    {
+        id: '', nodetype: 'approval', label: 'Approval', position: { x: 0, y: 0 },
+        groupList: [2],
+        icon: {
+            name: "approval",
+            color: "linear-gradient(135deg, var(--green-1), var(--green-8))" // green
+        },
+        data: {
+            approvers: {
+                type: ArrayDataType.STRING,
+                stringitemsList: []
+            },
+            type: "hours",
+            interval: 0
+        },
+        inputsList: ["input"],
+        outputsList: ["approved", "rejected", "timeout", "error"]
     },
     {
         id: '', nodetype: 'api', label: 'Rest API', position: { x: 0, y: 0 },
@@ -141,7 +231,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'log', label: 'Logging', position: { x: 0, y: 0 },
@@ -154,7 +244,7 @@ export const nodes: Node.AsObject[] = [
             message: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'getGuid', label: 'Get Guid', position: { x: 0, y: 0 },
@@ -167,7 +257,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     } as any,
     {
         id: '', nodetype: 'text', label: 'Text', position: { x: 0, y: 0 },
@@ -181,7 +271,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'math', label: 'math', position: { x: 0, y: 0 },
@@ -195,7 +285,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'list', label: 'List', position: { x: 0, y: 0 },
@@ -211,7 +301,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'count', label: 'List Count', position: { x: 0, y: 0 },
@@ -225,7 +315,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'map', label: 'Map', position: { x: 0, y: 0 },
@@ -240,7 +330,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'replace', label: 'Replace', position: { x: 0, y: 0 },
@@ -256,7 +346,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         id: '', nodetype: 'findAll', label: 'Find All', position: { x: 0, y: 0 },
@@ -271,7 +361,7 @@ export const nodes: Node.AsObject[] = [
             variable: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     },
     {
         // Implement logic on Front End
@@ -299,7 +389,7 @@ export const nodes: Node.AsObject[] = [
             subprocessid: ""
         },
         inputsList: ["input"],
-        outputsList: ["output"]
+        outputsList: ["output", "error"]
     }
 ]
 
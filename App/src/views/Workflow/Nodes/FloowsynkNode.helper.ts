@@ -1,5 +1,7 @@
 import { Ref } from 'vue'
 import { GraphNode } from "@vue-flow/core"
+import { Workflow } from 'proto/workflow/workflow_pb'
+import { nodes } from './FloowsynkNode.contants'
 
 export const clickhandler = (node: GraphNode<any, any, string>, showSidebar: Ref<boolean>, showModal: Ref<boolean>) => {
     if (node.id == '0') {
@@ -7,4 +9,21 @@ export const clickhandler = (node: GraphNode<any, any, string>, showSidebar: Ref
     } else {
         showSidebar.value = true
     }
-}
\ No newline at end of file
+}
+
+// Loop nodes saved before they had body and done handles have a single output
+// handle, and every edge leaving it is the loop body
+export const upgradeLoopHandles = (workflow: Workflow.AsObject) => {
+    const loopIds = new Set<string>()
+    workflow.nodesList?.forEach(node => {
+        if (!['loop', 'foreach', 'while'].includes(node.nodetype)) return
+        loopIds.add(node.id)
+        node.outputsList = [...(nodes.find(n => n.nodetype === node.nodetype)?.outputsList || [])]
+    })
+    workflow.edgesList?.forEach(edge => {
+        if (loopIds.has(edge.source) && (edge.sourcehandle === 'output' || !edge.sourcehandle)) {
+            edge.sourcehandle = 'body'
+        }
+    })
+    return workflow
+}
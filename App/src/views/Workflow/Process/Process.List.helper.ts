@@ -2,6 +2,7 @@ import { useProcessListStore } from './Process.List.hooks'
 import { getAllWorkflows, listWorkflowRunHistory, getWorkflowHistory } from './Process.List.api'
 import { useWorkflowStore, newProcess } from '@/views/Workflow'
 import { startNodes } from '@/views/Workflow/Nodes/FloowsynkNode.contants'
+import { upgradeLoopHandles } from '@/views/Workflow/Nodes/FloowsynkNode.helper'
 import { Workflow, WorkflowHistory, NodeStatus } from 'proto/workflow/workflow_pb'
 import { useWorkflowCanvasStore } from '../Canvas/Workflow.Canvas.hooks'
 
@@ -12,7 +13,7 @@ export const initWorkflows = async () => {
     
     const workflows: Workflow[] = respProcesses.getWorkflowsList() || []
     workflows.forEach((process: Workflow) => {
-        const p = process.toObject()
+        const p = upgradeLoopHandles(process.toObject())
         processes.value.push({ isnew: false, ...p })
     })
 
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -81,6 +82,9 @@ const (
 	NodeStatus_COMPLETED NodeStatus = 1
 	NodeStatus_FAILED    NodeStatus = 2
 	NodeStatus_INFO      NodeStatus = 3
+	NodeStatus_CANCELLED NodeStatus = 4
+	NodeStatus_WAITING   NodeStatus = 5
+	NodeStatus_PAUSED    NodeStatus = 6
 )
 
 // Enum value maps for NodeStatus.
@@ -90,12 +94,18 @@ var (
 		1: "COMPLETED",
 		2: "FAILED",
 		3: "INFO",
+		4: "CANCELLED",
+		5: "WAITING",
+		6: "PAUSED",
 	}
 	NodeStatus_value = map[string]int32{
 		"RUNNING":   0,
 		"COMPLETED": 1,
 		"FAILED":    2,
 		"INFO":      3,
+		"CANCELLED": 4,
+		"WAITING":   5,
+		"PAUSED":    6,
 	}
 )
 
@@ -170,6 +180,418 @@ func (x *RunWorkflowIdRequest) GetId() string {
 	return ""
 }
 
+type CancelRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=runId,proto3" json:"runId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRunRequest) Reset() {
+	*x = CancelRunRequest{}
+	mi := &file_workflow_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRunRequest) ProtoMessage() {}
+
+func (x *CancelRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRunRequest.ProtoReflect.Descriptor instead.
+func (*CancelRunRequest) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CancelRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type ResumeRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessId     string                 `protobuf:"bytes,1,opt,name=processId,proto3" json:"processId,omitempty"`
+	FromNodeId    string                 `protobuf:"bytes,2,opt,name=fromNodeId,proto3" json:"fromNodeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeRunRequest) Reset() {
+	*x = ResumeRunRequest{}
+	mi := &file_workflow_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRunRequest) ProtoMessage() {}
+
+func (x *ResumeRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRunRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRunRequest) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResumeRunRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *ResumeRunRequest) GetFromNodeId() string {
+	if x != nil {
+		return x.FromNodeId
+	}
+	return ""
+}
+
+type DebugRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workflow      *Workflow              `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Breakpoints   []string               `protobuf:"bytes,2,rep,name=breakpoints,proto3" json:"breakpoints,omitempty"`
+	PauseAtStart  bool                   `protobuf:"varint,3,opt,name=pauseAtStart,proto3" json:"pauseAtStart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebugRunRequest) Reset() {
+	*x = DebugRunRequest{}
+	mi := &file_workflow_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebugRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugRunRequest) ProtoMessage() {}
+
+func (x *DebugRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugRunRequest.ProtoReflect.Descriptor instead.
+func (*DebugRunRequest) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DebugRunRequest) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+func (x *DebugRunRequest) GetBreakpoints() []string {
+	if x != nil {
+		return x.Breakpoints
+	}
+	return nil
+}
+
+func (x *DebugRunRequest) GetPauseAtStart() bool {
+	if x != nil {
+		return x.PauseAtStart
+	}
+	return false
+}
+
+type DebugCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessId     string                 `protobuf:"bytes,1,opt,name=processId,proto3" json:"processId,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Breakpoints   []string               `protobuf:"bytes,3,rep,name=breakpoints,proto3" json:"breakpoints,omitempty"`
+	Variables     *structpb.Struct       `protobuf:"bytes,4,opt,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebugCommand) Reset() {
+	*x = DebugCommand{}
+	mi := &file_workflow_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebugCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugCommand) ProtoMessage() {}
+
+func (x *DebugCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugCommand.ProtoReflect.Descriptor instead.
+func (*DebugCommand) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DebugCommand) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *DebugCommand) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DebugCommand) GetBreakpoints() []string {
+	if x != nil {
+		return x.Breakpoints
+	}
+	return nil
+}
+
+func (x *DebugCommand) GetVariables() *structpb.Struct {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type StepDecisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessId     string                 `protobuf:"bytes,1,opt,name=processId,proto3" json:"processId,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepDecisionRequest) Reset() {
+	*x = StepDecisionRequest{}
+	mi := &file_workflow_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepDecisionRequest) ProtoMessage() {}
+
+func (x *StepDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepDecisionRequest.ProtoReflect.Descriptor instead.
+func (*StepDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StepDecisionRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *StepDecisionRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *StepDecisionRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ValidationIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	EdgeId        string                 `protobuf:"bytes,2,opt,name=edgeId,proto3" json:"edgeId,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationIssue) Reset() {
+	*x = ValidationIssue{}
+	mi := &file_workflow_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationIssue) ProtoMessage() {}
+
+func (x *ValidationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationIssue.ProtoReflect.Descriptor instead.
+func (*ValidationIssue) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ValidationIssue) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetEdgeId() string {
+	if x != nil {
+		return x.EdgeId
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Issues        []*ValidationIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationResult) Reset() {
+	*x = ValidationResult{}
+	mi := &file_workflow_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationResult) ProtoMessage() {}
+
+func (x *ValidationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationResult.ProtoReflect.Descriptor instead.
+func (*ValidationResult) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidationResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidationResult) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type WorkflowHistoryList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	History       []*WorkflowHistory     `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
@@ -179,7 +601,7 @@ type WorkflowHistoryList struct {
 
 func (x *WorkflowHistoryList) Reset() {
 	*x = WorkflowHistoryList{}
-	mi := &file_workflow_proto_msgTypes[1]
+	mi := &file_workflow_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +613,7 @@ func (x *WorkflowHistoryList) String() string {
 func (*WorkflowHistoryList) ProtoMessage() {}
 
 func (x *WorkflowHistoryList) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[1]
+	mi := &file_workflow_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +626,7 @@ func (x *WorkflowHistoryList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowHistoryList.ProtoReflect.Descriptor instead.
 func (*WorkflowHistoryList) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{1}
+	return file_workflow_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WorkflowHistoryList) GetHistory() []*WorkflowHistory {
@@ -227,7 +649,7 @@ type WorkflowHistory struct {
 
 func (x *WorkflowHistory) Reset() {
 	*x = WorkflowHistory{}
-	mi := &file_workflow_proto_msgTypes[2]
+	mi := &file_workflow_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +661,7 @@ func (x *WorkflowHistory) String() string {
 func (*WorkflowHistory) ProtoMessage() {}
 
 func (x *WorkflowHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[2]
+	mi := &file_workflow_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +674,7 @@ func (x *WorkflowHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowHistory.ProtoReflect.Descriptor instead.
 func (*WorkflowHistory) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{2}
+	return file_workflow_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WorkflowHistory) GetId() string {
@@ -299,7 +721,7 @@ type WorkflowHistoryRequest struct {
 
 func (x *WorkflowHistoryRequest) Reset() {
 	*x = WorkflowHistoryRequest{}
-	mi := &file_workflow_proto_msgTypes[3]
+	mi := &file_workflow_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +733,7 @@ func (x *WorkflowHistoryRequest) String() string {
 func (*WorkflowHistoryRequest) ProtoMessage() {}
 
 func (x *WorkflowHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[3]
+	mi := &file_workflow_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +746,7 @@ func (x *WorkflowHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowHistoryRequest.ProtoReflect.Descriptor instead.
 func (*WorkflowHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{3}
+	return file_workflow_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WorkflowHistoryRequest) GetId() string {
@@ -343,7 +765,7 @@ type WorkflowHistoryResponse struct {
 
 func (x *WorkflowHistoryResponse) Reset() {
 	*x = WorkflowHistoryResponse{}
-	mi := &file_workflow_proto_msgTypes[4]
+	mi := &file_workflow_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +777,7 @@ func (x *WorkflowHistoryResponse) String() string {
 func (*WorkflowHistoryResponse) ProtoMessage() {}
 
 func (x *WorkflowHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[4]
+	mi := &file_workflow_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +790,7 @@ func (x *WorkflowHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowHistoryResponse.ProtoReflect.Descriptor instead.
 func (*WorkflowHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{4}
+	return file_workflow_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WorkflowHistoryResponse) GetData() []*ReplayData {
@@ -379,19 +801,21 @@ func (x *WorkflowHistoryResponse) GetData() []*ReplayData {
 }
 
 type ReplayData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	Data          *NodeData              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Variables     map[string]string      `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Status        NodeStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=proto.NodeStatus" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NodeId         string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Data           *NodeData              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Variables      map[string]string      `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status         NodeStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=proto.NodeStatus" json:"status,omitempty"`
+	Message        string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	ProcessId      string                 `protobuf:"bytes,6,opt,name=processId,proto3" json:"processId,omitempty"`
+	TypedVariables *structpb.Struct       `protobuf:"bytes,7,opt,name=typedVariables,proto3" json:"typedVariables,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ReplayData) Reset() {
 	*x = ReplayData{}
-	mi := &file_workflow_proto_msgTypes[5]
+	mi := &file_workflow_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +827,7 @@ func (x *ReplayData) String() string {
 func (*ReplayData) ProtoMessage() {}
 
 func (x *ReplayData) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[5]
+	mi := &file_workflow_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +840,7 @@ func (x *ReplayData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplayData.ProtoReflect.Descriptor instead.
 func (*ReplayData) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{5}
+	return file_workflow_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ReplayData) GetNodeId() string {
@@ -454,6 +878,20 @@ func (x *ReplayData) GetMessage() string {
 	return ""
 }
 
+func (x *ReplayData) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *ReplayData) GetTypedVariables() *structpb.Struct {
+	if x != nil {
+		return x.TypedVariables
+	}
+	return nil
+}
+
 type PageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -464,7 +902,7 @@ type PageRequest struct {
 
 func (x *PageRequest) Reset() {
 	*x = PageRequest{}
-	mi := &file_workflow_proto_msgTypes[6]
+	mi := &file_workflow_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +914,7 @@ func (x *PageRequest) String() string {
 func (*PageRequest) ProtoMessage() {}
 
 func (x *PageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[6]
+	mi := &file_workflow_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +927,7 @@ func (x *PageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
 func (*PageRequest) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{6}
+	return file_workflow_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PageRequest) GetLimit() int32 {
@@ -515,7 +953,7 @@ type GetWorkflowRequest struct {
 
 func (x *GetWorkflowRequest) Reset() {
 	*x = GetWorkflowRequest{}
-	mi := &file_workflow_proto_msgTypes[7]
+	mi := &file_workflow_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +965,7 @@ func (x *GetWorkflowRequest) String() string {
 func (*GetWorkflowRequest) ProtoMessage() {}
 
 func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[7]
+	mi := &file_workflow_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +978,7 @@ func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{7}
+	return file_workflow_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetWorkflowRequest) GetId() string {
@@ -560,7 +998,7 @@ type WorkflowList struct {
 
 func (x *WorkflowList) Reset() {
 	*x = WorkflowList{}
-	mi := &file_workflow_proto_msgTypes[8]
+	mi := &file_workflow_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +1010,7 @@ func (x *WorkflowList) String() string {
 func (*WorkflowList) ProtoMessage() {}
 
 func (x *WorkflowList) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[8]
+	mi := &file_workflow_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +1023,7 @@ func (x *WorkflowList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowList.ProtoReflect.Descriptor instead.
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{8}
+	return file_workflow_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *WorkflowList) GetTotal() int32 {
@@ -616,13 +1054,14 @@ type Workflow struct {
 	UpdatedBy     string                 `protobuf:"bytes,10,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
 	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
 	IsNew         *bool                  `protobuf:"varint,12,opt,name=isNew,proto3,oneof" json:"isNew,omitempty"`
+	TimeoutMs     *int32                 `protobuf:"varint,13,opt,name=timeoutMs,proto3,oneof" json:"timeoutMs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Workflow) Reset() {
 	*x = Workflow{}
-	mi := &file_workflow_proto_msgTypes[9]
+	mi := &file_workflow_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -634,7 +1073,7 @@ func (x *Workflow) String() string {
 func (*Workflow) ProtoMessage() {}
 
 func (x *Workflow) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[9]
+	mi := &file_workflow_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,7 +1086,7 @@ func (x *Workflow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{9}
+	return file_workflow_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Workflow) GetId() string {
@@ -734,6 +1173,13 @@ func (x *Workflow) GetIsNew() bool {
 	return false
 }
 
+func (x *Workflow) GetTimeoutMs() int32 {
+	if x != nil && x.TimeoutMs != nil {
+		return *x.TimeoutMs
+	}
+	return 0
+}
+
 type Edge struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -757,7 +1203,7 @@ type Edge struct {
 
 func (x *Edge) Reset() {
 	*x = Edge{}
-	mi := &file_workflow_proto_msgTypes[10]
+	mi := &file_workflow_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +1215,7 @@ func (x *Edge) String() string {
 func (*Edge) ProtoMessage() {}
 
 func (x *Edge) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[10]
+	mi := &file_workflow_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +1228,7 @@ func (x *Edge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Edge.ProtoReflect.Descriptor instead.
 func (*Edge) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{10}
+	return file_workflow_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Edge) GetId() string {
@@ -914,7 +1360,7 @@ type Node struct {
 
 func (x *Node) Reset() {
 	*x = Node{}
-	mi := &file_workflow_proto_msgTypes[11]
+	mi := &file_workflow_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -926,7 +1372,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[11]
+	mi := &file_workflow_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,7 +1385,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{11}
+	return file_workflow_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Node) GetId() string {
@@ -1078,13 +1524,26 @@ type NodeData struct {
 	Type          *string                `protobuf:"bytes,20,opt,name=type,proto3,oneof" json:"type,omitempty"`
 	Interval      *int32                 `protobuf:"varint,21,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
 	Weeks         *NodeDataArray         `protobuf:"bytes,22,opt,name=weeks,proto3,oneof" json:"weeks,omitempty"`
+	Parallel      *bool                  `protobuf:"varint,23,opt,name=parallel,proto3,oneof" json:"parallel,omitempty"`
+	Concurrency   *int32                 `protobuf:"varint,24,opt,name=concurrency,proto3,oneof" json:"concurrency,omitempty"`
+	Mode          *string                `protobuf:"bytes,25,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Required      *int32                 `protobuf:"varint,26,opt,name=required,proto3,oneof" json:"required,omitempty"`
+	MergeAs       *string                `protobuf:"bytes,27,opt,name=mergeAs,proto3,oneof" json:"mergeAs,omitempty"`
+	Retry         *RetryPolicy           `protobuf:"bytes,28,opt,name=retry,proto3,oneof" json:"retry,omitempty"`
+	TimeoutMs     *int32                 `protobuf:"varint,29,opt,name=timeoutMs,proto3,oneof" json:"timeoutMs,omitempty"`
+	Approvers     *NodeDataArray         `protobuf:"bytes,30,opt,name=approvers,proto3,oneof" json:"approvers,omitempty"`
+	Cases         *NodeDataArray         `protobuf:"bytes,31,opt,name=cases,proto3,oneof" json:"cases,omitempty"`
+	Inputs        *NodeDataArray         `protobuf:"bytes,32,opt,name=inputs,proto3,oneof" json:"inputs,omitempty"`
+	Outputs       *NodeDataArray         `protobuf:"bytes,33,opt,name=outputs,proto3,oneof" json:"outputs,omitempty"`
+	PlainText     *bool                  `protobuf:"varint,34,opt,name=plainText,proto3,oneof" json:"plainText,omitempty"`
+	ParallelItems *bool                  `protobuf:"varint,35,opt,name=parallelItems,proto3,oneof" json:"parallelItems,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NodeData) Reset() {
 	*x = NodeData{}
-	mi := &file_workflow_proto_msgTypes[12]
+	mi := &file_workflow_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1096,7 +1555,7 @@ func (x *NodeData) String() string {
 func (*NodeData) ProtoMessage() {}
 
 func (x *NodeData) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[12]
+	mi := &file_workflow_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,7 +1568,7 @@ func (x *NodeData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeData.ProtoReflect.Descriptor instead.
 func (*NodeData) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{12}
+	return file_workflow_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *NodeData) GetName() string {
@@ -1266,6 +1725,173 @@ func (x *NodeData) GetWeeks() *NodeDataArray {
 	return nil
 }
 
+func (x *NodeData) GetParallel() bool {
+	if x != nil && x.Parallel != nil {
+		return *x.Parallel
+	}
+	return false
+}
+
+func (x *NodeData) GetConcurrency() int32 {
+	if x != nil && x.Concurrency != nil {
+		return *x.Concurrency
+	}
+	return 0
+}
+
+func (x *NodeData) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *NodeData) GetRequired() int32 {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return 0
+}
+
+func (x *NodeData) GetMergeAs() string {
+	if x != nil && x.MergeAs != nil {
+		return *x.MergeAs
+	}
+	return ""
+}
+
+func (x *NodeData) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *NodeData) GetTimeoutMs() int32 {
+	if x != nil && x.TimeoutMs != nil {
+		return *x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *NodeData) GetApprovers() *NodeDataArray {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *NodeData) GetCases() *NodeDataArray {
+	if x != nil {
+		return x.Cases
+	}
+	return nil
+}
+
+func (x *NodeData) GetInputs() *NodeDataArray {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *NodeData) GetOutputs() *NodeDataArray {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *NodeData) GetPlainText() bool {
+	if x != nil && x.PlainText != nil {
+		return *x.PlainText
+	}
+	return false
+}
+
+func (x *NodeData) GetParallelItems() bool {
+	if x != nil && x.ParallelItems != nil {
+		return *x.ParallelItems
+	}
+	return false
+}
+
+type RetryPolicy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts    int32                  `protobuf:"varint,1,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	InitialDelayMs int32                  `protobuf:"varint,2,opt,name=initialDelayMs,proto3" json:"initialDelayMs,omitempty"`
+	Multiplier     float64                `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	MaxDelayMs     int32                  `protobuf:"varint,4,opt,name=maxDelayMs,proto3" json:"maxDelayMs,omitempty"`
+	RetryOn        []string               `protobuf:"bytes,5,rep,name=retryOn,proto3" json:"retryOn,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_workflow_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialDelayMs() int32 {
+	if x != nil {
+		return x.InitialDelayMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxDelayMs() int32 {
+	if x != nil {
+		return x.MaxDelayMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
 type NodeDataArray struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          ArrayDataType          `protobuf:"varint,1,opt,name=type,proto3,enum=proto.ArrayDataType" json:"type,omitempty"`
@@ -1279,7 +1905,7 @@ type NodeDataArray struct {
 
 func (x *NodeDataArray) Reset() {
 	*x = NodeDataArray{}
-	mi := &file_workflow_proto_msgTypes[13]
+	mi := &file_workflow_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1291,7 +1917,7 @@ func (x *NodeDataArray) String() string {
 func (*NodeDataArray) ProtoMessage() {}
 
 func (x *NodeDataArray) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[13]
+	mi := &file_workflow_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1304,7 +1930,7 @@ func (x *NodeDataArray) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeDataArray.ProtoReflect.Descriptor instead.
 func (*NodeDataArray) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{13}
+	return file_workflow_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *NodeDataArray) GetType() ArrayDataType {
@@ -1352,7 +1978,7 @@ type NodeIcon struct {
 
 func (x *NodeIcon) Reset() {
 	*x = NodeIcon{}
-	mi := &file_workflow_proto_msgTypes[14]
+	mi := &file_workflow_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1364,7 +1990,7 @@ func (x *NodeIcon) String() string {
 func (*NodeIcon) ProtoMessage() {}
 
 func (x *NodeIcon) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[14]
+	mi := &file_workflow_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,7 +2003,7 @@ func (x *NodeIcon) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeIcon.ProtoReflect.Descriptor instead.
 func (*NodeIcon) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{14}
+	return file_workflow_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *NodeIcon) GetName() string {
@@ -1404,7 +2030,7 @@ type NodeDimensions struct {
 
 func (x *NodeDimensions) Reset() {
 	*x = NodeDimensions{}
-	mi := &file_workflow_proto_msgTypes[15]
+	mi := &file_workflow_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1416,7 +2042,7 @@ func (x *NodeDimensions) String() string {
 func (*NodeDimensions) ProtoMessage() {}
 
 func (x *NodeDimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[15]
+	mi := &file_workflow_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,7 +2055,7 @@ func (x *NodeDimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeDimensions.ProtoReflect.Descriptor instead.
 func (*NodeDimensions) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{15}
+	return file_workflow_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *NodeDimensions) GetWidth() float32 {
@@ -1457,7 +2083,7 @@ type NodePosition struct {
 
 func (x *NodePosition) Reset() {
 	*x = NodePosition{}
-	mi := &file_workflow_proto_msgTypes[16]
+	mi := &file_workflow_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1469,7 +2095,7 @@ func (x *NodePosition) String() string {
 func (*NodePosition) ProtoMessage() {}
 
 func (x *NodePosition) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[16]
+	mi := &file_workflow_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,7 +2108,7 @@ func (x *NodePosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodePosition.ProtoReflect.Descriptor instead.
 func (*NodePosition) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{16}
+	return file_workflow_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *NodePosition) GetX() float32 {
@@ -1516,7 +2142,7 @@ type KeyValue struct {
 
 func (x *KeyValue) Reset() {
 	*x = KeyValue{}
-	mi := &file_workflow_proto_msgTypes[17]
+	mi := &file_workflow_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1528,7 +2154,7 @@ func (x *KeyValue) String() string {
 func (*KeyValue) ProtoMessage() {}
 
 func (x *KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[17]
+	mi := &file_workflow_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1541,7 +2167,7 @@ func (x *KeyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyValue.ProtoReflect.Descriptor instead.
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{17}
+	return file_workflow_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *KeyValue) GetKey() string {
@@ -1568,7 +2194,7 @@ type NodeHandleBounds struct {
 
 func (x *NodeHandleBounds) Reset() {
 	*x = NodeHandleBounds{}
-	mi := &file_workflow_proto_msgTypes[18]
+	mi := &file_workflow_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1580,7 +2206,7 @@ func (x *NodeHandleBounds) String() string {
 func (*NodeHandleBounds) ProtoMessage() {}
 
 func (x *NodeHandleBounds) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[18]
+	mi := &file_workflow_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,7 +2219,7 @@ func (x *NodeHandleBounds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeHandleBounds.ProtoReflect.Descriptor instead.
 func (*NodeHandleBounds) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{18}
+	return file_workflow_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *NodeHandleBounds) GetSource() []*Handle {
@@ -1626,7 +2252,7 @@ type Handle struct {
 
 func (x *Handle) Reset() {
 	*x = Handle{}
-	mi := &file_workflow_proto_msgTypes[19]
+	mi := &file_workflow_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1638,7 +2264,7 @@ func (x *Handle) String() string {
 func (*Handle) ProtoMessage() {}
 
 func (x *Handle) ProtoReflect() protoreflect.Message {
-	mi := &file_workflow_proto_msgTypes[19]
+	mi := &file_workflow_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,7 +2277,7 @@ func (x *Handle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Handle.ProtoReflect.Descriptor instead.
 func (*Handle) Descriptor() ([]byte, []int) {
-	return file_workflow_proto_rawDescGZIP(), []int{19}
+	return file_workflow_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Handle) GetX() float32 {
@@ -1714,9 +2340,38 @@ var File_workflow_proto protoreflect.FileDescriptor
 
 const file_workflow_proto_rawDesc = "" +
 	"\n" +
-	"\x0eworkflow.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\"&\n" +
+	"\x0eworkflow.proto\x12\x05proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"&\n" +
 	"\x14RunWorkflowIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
+	"\x10CancelRunRequest\x12\x14\n" +
+	"\x05runId\x18\x01 \x01(\tR\x05runId\"P\n" +
+	"\x10ResumeRunRequest\x12\x1c\n" +
+	"\tprocessId\x18\x01 \x01(\tR\tprocessId\x12\x1e\n" +
+	"\n" +
+	"fromNodeId\x18\x02 \x01(\tR\n" +
+	"fromNodeId\"\x84\x01\n" +
+	"\x0fDebugRunRequest\x12+\n" +
+	"\bworkflow\x18\x01 \x01(\v2\x0f.proto.WorkflowR\bworkflow\x12 \n" +
+	"\vbreakpoints\x18\x02 \x03(\tR\vbreakpoints\x12\"\n" +
+	"\fpauseAtStart\x18\x03 \x01(\bR\fpauseAtStart\"\x9d\x01\n" +
+	"\fDebugCommand\x12\x1c\n" +
+	"\tprocessId\x18\x01 \x01(\tR\tprocessId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12 \n" +
+	"\vbreakpoints\x18\x03 \x03(\tR\vbreakpoints\x125\n" +
+	"\tvariables\x18\x04 \x01(\v2\x17.google.protobuf.StructR\tvariables\"e\n" +
+	"\x13StepDecisionRequest\x12\x1c\n" +
+	"\tprocessId\x18\x01 \x01(\tR\tprocessId\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\x8d\x01\n" +
+	"\x0fValidationIssue\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06edgeId\x18\x02 \x01(\tR\x06edgeId\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"X\n" +
+	"\x10ValidationResult\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12.\n" +
+	"\x06issues\x18\x02 \x03(\v2\x16.proto.ValidationIssueR\x06issues\"G\n" +
 	"\x13WorkflowHistoryList\x120\n" +
 	"\ahistory\x18\x01 \x03(\v2\x16.proto.WorkflowHistoryR\ahistory\"\xaa\x01\n" +
 	"\x0fWorkflowHistory\x12\x0e\n" +
@@ -1730,14 +2385,16 @@ const file_workflow_proto_rawDesc = "" +
 	"\x16WorkflowHistoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
 	"\x17WorkflowHistoryResponse\x12%\n" +
-	"\x04data\x18\x01 \x03(\v2\x11.proto.ReplayDataR\x04data\"\x8c\x02\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.proto.ReplayDataR\x04data\"\xeb\x02\n" +
 	"\n" +
 	"ReplayData\x12\x16\n" +
 	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12#\n" +
 	"\x04data\x18\x02 \x01(\v2\x0f.proto.NodeDataR\x04data\x12>\n" +
 	"\tvariables\x18\x03 \x03(\v2 .proto.ReplayData.VariablesEntryR\tvariables\x12)\n" +
 	"\x06status\x18\x04 \x01(\x0e2\x11.proto.NodeStatusR\x06status\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage\x1a<\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1c\n" +
+	"\tprocessId\x18\x06 \x01(\tR\tprocessId\x12?\n" +
+	"\x0etypedVariables\x18\a \x01(\v2\x17.google.protobuf.StructR\x0etypedVariables\x1a<\n" +
 	"\x0eVariablesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
@@ -1748,7 +2405,7 @@ const file_workflow_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
 	"\fWorkflowList\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12-\n" +
-	"\tworkflows\x18\x02 \x03(\v2\x0f.proto.WorkflowR\tworkflows\"\xdb\x02\n" +
+	"\tworkflows\x18\x02 \x03(\v2\x0f.proto.WorkflowR\tworkflows\"\x8c\x03\n" +
 	"\bWorkflow\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1762,8 +2419,11 @@ const file_workflow_proto_rawDesc = "" +
 	"\tupdatedBy\x18\n" +
 	" \x01(\tR\tupdatedBy\x12\x12\n" +
 	"\x04tags\x18\v \x03(\tR\x04tags\x12\x19\n" +
-	"\x05isNew\x18\f \x01(\bH\x00R\x05isNew\x88\x01\x01B\b\n" +
-	"\x06_isNew\"\xac\x03\n" +
+	"\x05isNew\x18\f \x01(\bH\x00R\x05isNew\x88\x01\x01\x12!\n" +
+	"\ttimeoutMs\x18\r \x01(\x05H\x01R\ttimeoutMs\x88\x01\x01B\b\n" +
+	"\x06_isNewB\f\n" +
+	"\n" +
+	"_timeoutMs\"\xac\x03\n" +
 	"\x04Edge\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
@@ -1815,7 +2475,7 @@ const file_workflow_proto_rawDesc = "" +
 	"\x05_iconB\v\n" +
 	"\t_positionB\r\n" +
 	"\v_nodestatusB\a\n" +
-	"\x05_type\"\xfb\a\n" +
+	"\x05_type\"\xb4\r\n" +
 	"\bNodeData\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
 	"\x05value\x18\x02 \x01(\tH\x01R\x05value\x88\x01\x01\x12#\n" +
@@ -1842,7 +2502,20 @@ const file_workflow_proto_rawDesc = "" +
 	"\fsubProcessId\x18\x13 \x01(\tH\x12R\fsubProcessId\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x14 \x01(\tH\x13R\x04type\x88\x01\x01\x12\x1f\n" +
 	"\binterval\x18\x15 \x01(\x05H\x14R\binterval\x88\x01\x01\x12/\n" +
-	"\x05weeks\x18\x16 \x01(\v2\x14.proto.NodeDataArrayH\x15R\x05weeks\x88\x01\x01B\a\n" +
+	"\x05weeks\x18\x16 \x01(\v2\x14.proto.NodeDataArrayH\x15R\x05weeks\x88\x01\x01\x12\x1f\n" +
+	"\bparallel\x18\x17 \x01(\bH\x16R\bparallel\x88\x01\x01\x12%\n" +
+	"\vconcurrency\x18\x18 \x01(\x05H\x17R\vconcurrency\x88\x01\x01\x12\x17\n" +
+	"\x04mode\x18\x19 \x01(\tH\x18R\x04mode\x88\x01\x01\x12\x1f\n" +
+	"\brequired\x18\x1a \x01(\x05H\x19R\brequired\x88\x01\x01\x12\x1d\n" +
+	"\amergeAs\x18\x1b \x01(\tH\x1aR\amergeAs\x88\x01\x01\x12-\n" +
+	"\x05retry\x18\x1c \x01(\v2\x12.proto.RetryPolicyH\x1bR\x05retry\x88\x01\x01\x12!\n" +
+	"\ttimeoutMs\x18\x1d \x01(\x05H\x1cR\ttimeoutMs\x88\x01\x01\x127\n" +
+	"\tapprovers\x18\x1e \x01(\v2\x14.proto.NodeDataArrayH\x1dR\tapprovers\x88\x01\x01\x12/\n" +
+	"\x05cases\x18\x1f \x01(\v2\x14.proto.NodeDataArrayH\x1eR\x05cases\x88\x01\x01\x121\n" +
+	"\x06inputs\x18  \x01(\v2\x14.proto.NodeDataArrayH\x1fR\x06inputs\x88\x01\x01\x123\n" +
+	"\aoutputs\x18! \x01(\v2\x14.proto.NodeDataArrayH R\aoutputs\x88\x01\x01\x12!\n" +
+	"\tplainText\x18\" \x01(\bH!R\tplainText\x88\x01\x01\x12)\n" +
+	"\rparallelItems\x18# \x01(\bH\"R\rparallelItems\x88\x01\x01B\a\n" +
 	"\x05_nameB\b\n" +
 	"\x06_valueB\r\n" +
 	"\v_expressionB\f\n" +
@@ -1870,7 +2543,35 @@ const file_workflow_proto_rawDesc = "" +
 	"\r_subProcessIdB\a\n" +
 	"\x05_typeB\v\n" +
 	"\t_intervalB\b\n" +
-	"\x06_weeks\"\xcc\x01\n" +
+	"\x06_weeksB\v\n" +
+	"\t_parallelB\x0e\n" +
+	"\f_concurrencyB\a\n" +
+	"\x05_modeB\v\n" +
+	"\t_requiredB\n" +
+	"\n" +
+	"\b_mergeAsB\b\n" +
+	"\x06_retryB\f\n" +
+	"\n" +
+	"_timeoutMsB\f\n" +
+	"\n" +
+	"_approversB\b\n" +
+	"\x06_casesB\t\n" +
+	"\a_inputsB\n" +
+	"\n" +
+	"\b_outputsB\f\n" +
+	"\n" +
+	"_plainTextB\x10\n" +
+	"\x0e_parallelItems\"\xb1\x01\n" +
+	"\vRetryPolicy\x12 \n" +
+	"\vmaxAttempts\x18\x01 \x01(\x05R\vmaxAttempts\x12&\n" +
+	"\x0einitialDelayMs\x18\x02 \x01(\x05R\x0einitialDelayMs\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x03 \x01(\x01R\n" +
+	"multiplier\x12\x1e\n" +
+	"\n" +
+	"maxDelayMs\x18\x04 \x01(\x05R\n" +
+	"maxDelayMs\x12\x18\n" +
+	"\aretryOn\x18\x05 \x03(\tR\aretryOn\"\xcc\x01\n" +
 	"\rNodeDataArray\x12(\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x14.proto.ArrayDataTypeR\x04type\x125\n" +
 	"\rkeyValueItems\x18\x02 \x03(\v2\x0f.proto.KeyValueR\rkeyValueItems\x12 \n" +
@@ -1908,14 +2609,18 @@ const file_workflow_proto_rawDesc = "" +
 	"\x06STRING\x10\x00\x12\a\n" +
 	"\x03INT\x10\x01\x12\b\n" +
 	"\x04BOOL\x10\x02\x12\f\n" +
-	"\bKEYVALUE\x10\x03*>\n" +
+	"\bKEYVALUE\x10\x03*f\n" +
 	"\n" +
 	"NodeStatus\x12\v\n" +
 	"\aRUNNING\x10\x00\x12\r\n" +
 	"\tCOMPLETED\x10\x01\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x02\x12\b\n" +
-	"\x04INFO\x10\x032\xbe\x04\n" +
+	"\x04INFO\x10\x03\x12\r\n" +
+	"\tCANCELLED\x10\x04\x12\v\n" +
+	"\aWAITING\x10\x05\x12\n" +
+	"\n" +
+	"\x06PAUSED\x10\x062\xf0\a\n" +
 	"\x0fWorkflowService\x129\n" +
 	"\vGetWorkflow\x12\x19.proto.GetWorkflowRequest\x1a\x0f.proto.Workflow\x128\n" +
 	"\rListWorkflows\x12\x12.proto.PageRequest\x1a\x13.proto.WorkflowList\x122\n" +
@@ -1925,7 +2630,15 @@ const file_workflow_proto_rawDesc = "" +
 	"\bQuickRun\x12\x0f.proto.Workflow\x1a\x11.proto.ReplayData0\x01\x12A\n" +
 	"\rRunWorkflowId\x12\x1b.proto.RunWorkflowIdRequest\x1a\x11.proto.ReplayData0\x01\x12I\n" +
 	"\x13ListWorkflowHistory\x12\x16.google.protobuf.Empty\x1a\x1a.proto.WorkflowHistoryList\x12S\n" +
-	"\x12GetWorkflowHistory\x12\x1d.proto.WorkflowHistoryRequest\x1a\x1e.proto.WorkflowHistoryResponseB\tZ\a./protob\x06proto3"
+	"\x12GetWorkflowHistory\x12\x1d.proto.WorkflowHistoryRequest\x1a\x1e.proto.WorkflowHistoryResponse\x12<\n" +
+	"\tCancelRun\x12\x17.proto.CancelRunRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\tResumeRun\x12\x17.proto.ResumeRunRequest\x1a\x11.proto.ReplayData0\x01\x12A\n" +
+	"\vApproveStep\x12\x1a.proto.StepDecisionRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\n" +
+	"RejectStep\x12\x1a.proto.StepDecisionRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\x10ValidateWorkflow\x12\x0f.proto.Workflow\x1a\x17.proto.ValidationResult\x127\n" +
+	"\bDebugRun\x12\x16.proto.DebugRunRequest\x1a\x11.proto.ReplayData0\x01\x12;\n" +
+	"\fDebugControl\x12\x13.proto.DebugCommand\x1a\x16.google.protobuf.EmptyB\tZ\a./protob\x06proto3"
 
 var (
 	file_workflow_proto_rawDescOnce sync.Once
@@ -1940,81 +2653,113 @@ func file_workflow_proto_rawDescGZIP() []byte {
 }
 
 var file_workflow_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_workflow_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_workflow_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_workflow_proto_goTypes = []any{
 	(ArrayDataType)(0),              // 0: proto.ArrayDataType
 	(NodeStatus)(0),                 // 1: proto.NodeStatus
 	(*RunWorkflowIdRequest)(nil),    // 2: proto.RunWorkflowIdRequest
-	(*WorkflowHistoryList)(nil),     // 3: proto.WorkflowHistoryList
-	(*WorkflowHistory)(nil),         // 4: proto.WorkflowHistory
-	(*WorkflowHistoryRequest)(nil),  // 5: proto.WorkflowHistoryRequest
-	(*WorkflowHistoryResponse)(nil), // 6: proto.WorkflowHistoryResponse
-	(*ReplayData)(nil),              // 7: proto.ReplayData
-	(*PageRequest)(nil),             // 8: proto.PageRequest
-	(*GetWorkflowRequest)(nil),      // 9: proto.GetWorkflowRequest
-	(*WorkflowList)(nil),            // 10: proto.WorkflowList
-	(*Workflow)(nil),                // 11: proto.Workflow
-	(*Edge)(nil),                    // 12: proto.Edge
-	(*Node)(nil),                    // 13: proto.Node
-	(*NodeData)(nil),                // 14: proto.NodeData
-	(*NodeDataArray)(nil),           // 15: proto.NodeDataArray
-	(*NodeIcon)(nil),                // 16: proto.NodeIcon
-	(*NodeDimensions)(nil),          // 17: proto.NodeDimensions
-	(*NodePosition)(nil),            // 18: proto.NodePosition
-	(*KeyValue)(nil),                // 19: proto.KeyValue
-	(*NodeHandleBounds)(nil),        // 20: proto.NodeHandleBounds
-	(*Handle)(nil),                  // 21: proto.Handle
-	nil,                             // 22: proto.ReplayData.VariablesEntry
-	(*emptypb.Empty)(nil),           // 23: google.protobuf.Empty
+	(*CancelRunRequest)(nil),        // 3: proto.CancelRunRequest
+	(*ResumeRunRequest)(nil),        // 4: proto.ResumeRunRequest
+	(*DebugRunRequest)(nil),         // 5: proto.DebugRunRequest
+	(*DebugCommand)(nil),            // 6: proto.DebugCommand
+	(*StepDecisionRequest)(nil),     // 7: proto.StepDecisionRequest
+	(*ValidationIssue)(nil),         // 8: proto.ValidationIssue
+	(*ValidationResult)(nil),        // 9: proto.ValidationResult
+	(*WorkflowHistoryList)(nil),     // 10: proto.WorkflowHistoryList
+	(*WorkflowHistory)(nil),         // 11: proto.WorkflowHistory
+	(*WorkflowHistoryRequest)(nil),  // 12: proto.WorkflowHistoryRequest
+	(*WorkflowHistoryResponse)(nil), // 13: proto.WorkflowHistoryResponse
+	(*ReplayData)(nil),              // 14: proto.ReplayData
+	(*PageRequest)(nil),             // 15: proto.PageRequest
+	(*GetWorkflowRequest)(nil),      // 16: proto.GetWorkflowRequest
+	(*WorkflowList)(nil),            // 17: proto.WorkflowList
+	(*Workflow)(nil),                // 18: proto.Workflow
+	(*Edge)(nil),                    // 19: proto.Edge
+	(*Node)(nil),                    // 20: proto.Node
+	(*NodeData)(nil),                // 21: proto.NodeData
+	(*RetryPolicy)(nil),             // 22: proto.RetryPolicy
+	(*NodeDataArray)(nil),           // 23: proto.NodeDataArray
+	(*NodeIcon)(nil),                // 24: proto.NodeIcon
+	(*NodeDimensions)(nil),          // 25: proto.NodeDimensions
+	(*NodePosition)(nil),            // 26: proto.NodePosition
+	(*KeyValue)(nil),                // 27: proto.KeyValue
+	(*NodeHandleBounds)(nil),        // 28: proto.NodeHandleBounds
+	(*Handle)(nil),                  // 29: proto.Handle
+	nil,                             // 30: proto.ReplayData.VariablesEntry
+	(*structpb.Struct)(nil),         // 31: google.protobuf.Struct
+	(*emptypb.Empty)(nil),           // 32: google.protobuf.Empty
 }
 var file_workflow_proto_depIdxs = []int32{
-	4,  // 0: proto.WorkflowHistoryList.history:type_name -> proto.WorkflowHistory
-	1,  // 1: proto.WorkflowHistory.Status:type_name -> proto.NodeStatus
-	7,  // 2: proto.WorkflowHistoryResponse.data:type_name -> proto.ReplayData
-	14, // 3: proto.ReplayData.data:type_name -> proto.NodeData
-	22, // 4: proto.ReplayData.variables:type_name -> proto.ReplayData.VariablesEntry
-	1,  // 5: proto.ReplayData.status:type_name -> proto.NodeStatus
-	11, // 6: proto.WorkflowList.workflows:type_name -> proto.Workflow
-	13, // 7: proto.Workflow.nodes:type_name -> proto.Node
-	12, // 8: proto.Workflow.edges:type_name -> proto.Edge
-	13, // 9: proto.Edge.sourcenode:type_name -> proto.Node
-	13, // 10: proto.Edge.targetnode:type_name -> proto.Node
-	14, // 11: proto.Node.data:type_name -> proto.NodeData
-	16, // 12: proto.Node.icon:type_name -> proto.NodeIcon
-	18, // 13: proto.Node.position:type_name -> proto.NodePosition
-	17, // 14: proto.Node.dimensions:type_name -> proto.NodeDimensions
-	20, // 15: proto.Node.handleBounds:type_name -> proto.NodeHandleBounds
-	18, // 16: proto.Node.computedPosition:type_name -> proto.NodePosition
-	15, // 17: proto.NodeData.headers:type_name -> proto.NodeDataArray
-	15, // 18: proto.NodeData.list:type_name -> proto.NodeDataArray
-	15, // 19: proto.NodeData.weeks:type_name -> proto.NodeDataArray
-	0,  // 20: proto.NodeDataArray.type:type_name -> proto.ArrayDataType
-	19, // 21: proto.NodeDataArray.keyValueItems:type_name -> proto.KeyValue
-	21, // 22: proto.NodeHandleBounds.source:type_name -> proto.Handle
-	21, // 23: proto.NodeHandleBounds.target:type_name -> proto.Handle
-	9,  // 24: proto.WorkflowService.GetWorkflow:input_type -> proto.GetWorkflowRequest
-	8,  // 25: proto.WorkflowService.ListWorkflows:input_type -> proto.PageRequest
-	11, // 26: proto.WorkflowService.UpdateWorkflow:input_type -> proto.Workflow
-	11, // 27: proto.WorkflowService.CreateWorkflow:input_type -> proto.Workflow
-	11, // 28: proto.WorkflowService.DeleteWorkflow:input_type -> proto.Workflow
-	11, // 29: proto.WorkflowService.QuickRun:input_type -> proto.Workflow
-	2,  // 30: proto.WorkflowService.RunWorkflowId:input_type -> proto.RunWorkflowIdRequest
-	23, // 31: proto.WorkflowService.ListWorkflowHistory:input_type -> google.protobuf.Empty
-	5,  // 32: proto.WorkflowService.GetWorkflowHistory:input_type -> proto.WorkflowHistoryRequest
-	11, // 33: proto.WorkflowService.GetWorkflow:output_type -> proto.Workflow
-	10, // 34: proto.WorkflowService.ListWorkflows:output_type -> proto.WorkflowList
-	11, // 35: proto.WorkflowService.UpdateWorkflow:output_type -> proto.Workflow
-	11, // 36: proto.WorkflowService.CreateWorkflow:output_type -> proto.Workflow
-	23, // 37: proto.WorkflowService.DeleteWorkflow:output_type -> google.protobuf.Empty
-	7,  // 38: proto.WorkflowService.QuickRun:output_type -> proto.ReplayData
-	7,  // 39: proto.WorkflowService.RunWorkflowId:output_type -> proto.ReplayData
-	3,  // 40: proto.WorkflowService.ListWorkflowHistory:output_type -> proto.WorkflowHistoryList
-	6,  // 41: proto.WorkflowService.GetWorkflowHistory:output_type -> proto.WorkflowHistoryResponse
-	33, // [33:42] is the sub-list for method output_type
-	24, // [24:33] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	18, // 0: proto.DebugRunRequest.workflow:type_name -> proto.Workflow
+	31, // 1: proto.DebugCommand.variables:type_name -> google.protobuf.Struct
+	8,  // 2: proto.ValidationResult.issues:type_name -> proto.ValidationIssue
+	11, // 3: proto.WorkflowHistoryList.history:type_name -> proto.WorkflowHistory
+	1,  // 4: proto.WorkflowHistory.Status:type_name -> proto.NodeStatus
+	14, // 5: proto.WorkflowHistoryResponse.data:type_name -> proto.ReplayData
+	21, // 6: proto.ReplayData.data:type_name -> proto.NodeData
+	30, // 7: proto.ReplayData.variables:type_name -> proto.ReplayData.VariablesEntry
+	1,  // 8: proto.ReplayData.status:type_name -> proto.NodeStatus
+	31, // 9: proto.ReplayData.typedVariables:type_name -> google.protobuf.Struct
+	18, // 10: proto.WorkflowList.workflows:type_name -> proto.Workflow
+	20, // 11: proto.Workflow.nodes:type_name -> proto.Node
+	19, // 12: proto.Workflow.edges:type_name -> proto.Edge
+	20, // 13: proto.Edge.sourcenode:type_name -> proto.Node
+	20, // 14: proto.Edge.targetnode:type_name -> proto.Node
+	21, // 15: proto.Node.data:type_name -> proto.NodeData
+	24, // 16: proto.Node.icon:type_name -> proto.NodeIcon
+	26, // 17: proto.Node.position:type_name -> proto.NodePosition
+	25, // 18: proto.Node.dimensions:type_name -> proto.NodeDimensions
+	28, // 19: proto.Node.handleBounds:type_name -> proto.NodeHandleBounds
+	26, // 20: proto.Node.computedPosition:type_name -> proto.NodePosition
+	23, // 21: proto.NodeData.headers:type_name -> proto.NodeDataArray
+	23, // 22: proto.NodeData.list:type_name -> proto.NodeDataArray
+	23, // 23: proto.NodeData.weeks:type_name -> proto.NodeDataArray
+	22, // 24: proto.NodeData.retry:type_name -> proto.RetryPolicy
+	23, // 25: proto.NodeData.approvers:type_name -> proto.NodeDataArray
+	23, // 26: proto.NodeData.cases:type_name -> proto.NodeDataArray
+	23, // 27: proto.NodeData.inputs:type_name -> proto.NodeDataArray
+	23, // 28: proto.NodeData.outputs:type_name -> proto.NodeDataArray
+	0,  // 29: proto.NodeDataArray.type:type_name -> proto.ArrayDataType
+	27, // 30: proto.NodeDataArray.keyValueItems:type_name -> proto.KeyValue
+	29, // 31: proto.NodeHandleBounds.source:type_name -> proto.Handle
+	29, // 32: proto.NodeHandleBounds.target:type_name -> proto.Handle
+	16, // 33: proto.WorkflowService.GetWorkflow:input_type -> proto.GetWorkflowRequest
+	15, // 34: proto.WorkflowService.ListWorkflows:input_type -> proto.PageRequest
+	18, // 35: proto.WorkflowService.UpdateWorkflow:input_type -> proto.Workflow
+	18, // 36: proto.WorkflowService.CreateWorkflow:input_type -> proto.Workflow
+	18, // 37: proto.WorkflowService.DeleteWorkflow:input_type -> proto.Workflow
+	18, // 38: proto.WorkflowService.QuickRun:input_type -> proto.Workflow
+	2,  // 39: proto.WorkflowService.RunWorkflowId:input_type -> proto.RunWorkflowIdRequest
+	32, // 40: proto.WorkflowService.ListWorkflowHistory:input_type -> google.protobuf.Empty
+	12, // 41: proto.WorkflowService.GetWorkflowHistory:input_type -> proto.WorkflowHistoryRequest
+	3,  // 42: proto.WorkflowService.CancelRun:input_type -> proto.CancelRunRequest
+	4,  // 43: proto.WorkflowService.ResumeRun:input_type -> proto.ResumeRunRequest
+	7,  // 44: proto.WorkflowService.ApproveStep:input_type -> proto.StepDecisionRequest
+	7,  // 45: proto.WorkflowService.RejectStep:input_type -> proto.StepDecisionRequest
+	18, // 46: proto.WorkflowService.ValidateWorkflow:input_type -> proto.Workflow
+	5,  // 47: proto.WorkflowService.DebugRun:input_type -> proto.DebugRunRequest
+	6,  // 48: proto.WorkflowService.DebugControl:input_type -> proto.DebugCommand
+	18, // 49: proto.WorkflowService.GetWorkflow:output_type -> proto.Workflow
+	17, // 50: proto.WorkflowService.ListWorkflows:output_type -> proto.WorkflowList
+	18, // 51: proto.WorkflowService.UpdateWorkflow:output_type -> proto.Workflow
+	18, // 52: proto.WorkflowService.CreateWorkflow:output_type -> proto.Workflow
+	32, // 53: proto.WorkflowService.DeleteWorkflow:output_type -> google.protobuf.Empty
+	14, // 54: proto.WorkflowService.QuickRun:output_type -> proto.ReplayData
+	14, // 55: proto.WorkflowService.RunWorkflowId:output_type -> proto.ReplayData
+	10, // 56: proto.WorkflowService.ListWorkflowHistory:output_type -> proto.WorkflowHistoryList
+	13, // 57: proto.WorkflowService.GetWorkflowHistory:output_type -> proto.WorkflowHistoryResponse
+	32, // 58: proto.WorkflowService.CancelRun:output_type -> google.protobuf.Empty
+	14, // 59: proto.WorkflowService.ResumeRun:output_type -> proto.ReplayData
+	32, // 60: proto.WorkflowService.ApproveStep:output_type -> google.protobuf.Empty
+	32, // 61: proto.WorkflowService.RejectStep:output_type -> google.protobuf.Empty
+	9,  // 62: proto.WorkflowService.ValidateWorkflow:output_type -> proto.ValidationResult
+	14, // 63: proto.WorkflowService.DebugRun:output_type -> proto.ReplayData
+	32, // 64: proto.WorkflowService.DebugControl:output_type -> google.protobuf.Empty
+	49, // [49:65] is the sub-list for method output_type
+	33, // [33:49] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_workflow_proto_init() }
@@ -2022,17 +2767,17 @@ func file_workflow_proto_init() {
 	if File_workflow_proto != nil {
 		return
 	}
-	file_workflow_proto_msgTypes[9].OneofWrappers = []any{}
-	file_workflow_proto_msgTypes[11].OneofWrappers = []any{}
-	file_workflow_proto_msgTypes[12].OneofWrappers = []any{}
 	file_workflow_proto_msgTypes[16].OneofWrappers = []any{}
+	file_workflow_proto_msgTypes[18].OneofWrappers = []any{}
+	file_workflow_proto_msgTypes[19].OneofWrappers = []any{}
+	file_workflow_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_workflow_proto_rawDesc), len(file_workflow_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   21,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
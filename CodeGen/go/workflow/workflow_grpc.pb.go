@@ -29,6 +29,13 @@ const (
 	WorkflowService_RunWorkflowId_FullMethodName       = "/proto.WorkflowService/RunWorkflowId"
 	WorkflowService_ListWorkflowHistory_FullMethodName = "/proto.WorkflowService/ListWorkflowHistory"
 	WorkflowService_GetWorkflowHistory_FullMethodName  = "/proto.WorkflowService/GetWorkflowHistory"
+	WorkflowService_CancelRun_FullMethodName           = "/proto.WorkflowService/CancelRun"
+	WorkflowService_ResumeRun_FullMethodName           = "/proto.WorkflowService/ResumeRun"
+	WorkflowService_ApproveStep_FullMethodName         = "/proto.WorkflowService/ApproveStep"
+	WorkflowService_RejectStep_FullMethodName          = "/proto.WorkflowService/RejectStep"
+	WorkflowService_ValidateWorkflow_FullMethodName    = "/proto.WorkflowService/ValidateWorkflow"
+	WorkflowService_DebugRun_FullMethodName            = "/proto.WorkflowService/DebugRun"
+	WorkflowService_DebugControl_FullMethodName        = "/proto.WorkflowService/DebugControl"
 )
 
 // WorkflowServiceClient is the client API for WorkflowService service.
@@ -44,6 +51,13 @@ type WorkflowServiceClient interface {
 	RunWorkflowId(ctx context.Context, in *RunWorkflowIdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplayData], error)
 	ListWorkflowHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkflowHistoryList, error)
 	GetWorkflowHistory(ctx context.Context, in *WorkflowHistoryRequest, opts ...grpc.CallOption) (*WorkflowHistoryResponse, error)
+	CancelRun(ctx context.Context, in *CancelRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResumeRun(ctx context.Context, in *ResumeRunRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplayData], error)
+	ApproveStep(ctx context.Context, in *StepDecisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RejectStep(ctx context.Context, in *StepDecisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ValidateWorkflow(ctx context.Context, in *Workflow, opts ...grpc.CallOption) (*ValidationResult, error)
+	DebugRun(ctx context.Context, in *DebugRunRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplayData], error)
+	DebugControl(ctx context.Context, in *DebugCommand, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type workflowServiceClient struct {
@@ -162,6 +176,94 @@ func (c *workflowServiceClient) GetWorkflowHistory(ctx context.Context, in *Work
 	return out, nil
 }
 
+func (c *workflowServiceClient) CancelRun(ctx context.Context, in *CancelRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkflowService_CancelRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ResumeRun(ctx context.Context, in *ResumeRunRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplayData], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WorkflowService_ServiceDesc.Streams[2], WorkflowService_ResumeRun_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ResumeRunRequest, ReplayData]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkflowService_ResumeRunClient = grpc.ServerStreamingClient[ReplayData]
+
+func (c *workflowServiceClient) ApproveStep(ctx context.Context, in *StepDecisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkflowService_ApproveStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) RejectStep(ctx context.Context, in *StepDecisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkflowService_RejectStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ValidateWorkflow(ctx context.Context, in *Workflow, opts ...grpc.CallOption) (*ValidationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidationResult)
+	err := c.cc.Invoke(ctx, WorkflowService_ValidateWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) DebugRun(ctx context.Context, in *DebugRunRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplayData], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WorkflowService_ServiceDesc.Streams[3], WorkflowService_DebugRun_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DebugRunRequest, ReplayData]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkflowService_DebugRunClient = grpc.ServerStreamingClient[ReplayData]
+
+func (c *workflowServiceClient) DebugControl(ctx context.Context, in *DebugCommand, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkflowService_DebugControl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowServiceServer is the server API for WorkflowService service.
 // All implementations must embed UnimplementedWorkflowServiceServer
 // for forward compatibility.
@@ -175,6 +277,13 @@ type WorkflowServiceServer interface {
 	RunWorkflowId(*RunWorkflowIdRequest, grpc.ServerStreamingServer[ReplayData]) error
 	ListWorkflowHistory(context.Context, *emptypb.Empty) (*WorkflowHistoryList, error)
 	GetWorkflowHistory(context.Context, *WorkflowHistoryRequest) (*WorkflowHistoryResponse, error)
+	CancelRun(context.Context, *CancelRunRequest) (*emptypb.Empty, error)
+	ResumeRun(*ResumeRunRequest, grpc.ServerStreamingServer[ReplayData]) error
+	ApproveStep(context.Context, *StepDecisionRequest) (*emptypb.Empty, error)
+	RejectStep(context.Context, *StepDecisionRequest) (*emptypb.Empty, error)
+	ValidateWorkflow(context.Context, *Workflow) (*ValidationResult, error)
+	DebugRun(*DebugRunRequest, grpc.ServerStreamingServer[ReplayData]) error
+	DebugControl(context.Context, *DebugCommand) (*emptypb.Empty, error)
 	mustEmbedUnimplementedWorkflowServiceServer()
 }
 
@@ -212,6 +321,27 @@ func (UnimplementedWorkflowServiceServer) ListWorkflowHistory(context.Context, *
 func (UnimplementedWorkflowServiceServer) GetWorkflowHistory(context.Context, *WorkflowHistoryRequest) (*WorkflowHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetWorkflowHistory not implemented")
 }
+func (UnimplementedWorkflowServiceServer) CancelRun(context.Context, *CancelRunRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelRun not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ResumeRun(*ResumeRunRequest, grpc.ServerStreamingServer[ReplayData]) error {
+	return status.Error(codes.Unimplemented, "method ResumeRun not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ApproveStep(context.Context, *StepDecisionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveStep not implemented")
+}
+func (UnimplementedWorkflowServiceServer) RejectStep(context.Context, *StepDecisionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectStep not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ValidateWorkflow(context.Context, *Workflow) (*ValidationResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateWorkflow not implemented")
+}
+func (UnimplementedWorkflowServiceServer) DebugRun(*DebugRunRequest, grpc.ServerStreamingServer[ReplayData]) error {
+	return status.Error(codes.Unimplemented, "method DebugRun not implemented")
+}
+func (UnimplementedWorkflowServiceServer) DebugControl(context.Context, *DebugCommand) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DebugControl not implemented")
+}
 func (UnimplementedWorkflowServiceServer) mustEmbedUnimplementedWorkflowServiceServer() {}
 func (UnimplementedWorkflowServiceServer) testEmbeddedByValue()                         {}
 
@@ -381,6 +511,118 @@ func _WorkflowService_GetWorkflowHistory_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_CancelRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).CancelRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkflowService_CancelRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).CancelRun(ctx, req.(*CancelRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ResumeRun_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeRunRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkflowServiceServer).ResumeRun(m, &grpc.GenericServerStream[ResumeRunRequest, ReplayData]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkflowService_ResumeRunServer = grpc.ServerStreamingServer[ReplayData]
+
+func _WorkflowService_ApproveStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ApproveStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkflowService_ApproveStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ApproveStep(ctx, req.(*StepDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_RejectStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RejectStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkflowService_RejectStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RejectStep(ctx, req.(*StepDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ValidateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Workflow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ValidateWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkflowService_ValidateWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ValidateWorkflow(ctx, req.(*Workflow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_DebugRun_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DebugRunRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkflowServiceServer).DebugRun(m, &grpc.GenericServerStream[DebugRunRequest, ReplayData]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkflowService_DebugRunServer = grpc.ServerStreamingServer[ReplayData]
+
+func _WorkflowService_DebugControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).DebugControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkflowService_DebugControl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).DebugControl(ctx, req.(*DebugCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkflowService_ServiceDesc is the grpc.ServiceDesc for WorkflowService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -416,6 +658,26 @@ var WorkflowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkflowHistory",
 			Handler:    _WorkflowService_GetWorkflowHistory_Handler,
 		},
+		{
+			MethodName: "CancelRun",
+			Handler:    _WorkflowService_CancelRun_Handler,
+		},
+		{
+			MethodName: "ApproveStep",
+			Handler:    _WorkflowService_ApproveStep_Handler,
+		},
+		{
+			MethodName: "RejectStep",
+			Handler:    _WorkflowService_RejectStep_Handler,
+		},
+		{
+			MethodName: "ValidateWorkflow",
+			Handler:    _WorkflowService_ValidateWorkflow_Handler,
+		},
+		{
+			MethodName: "DebugControl",
+			Handler:    _WorkflowService_DebugControl_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -428,6 +690,16 @@ var WorkflowService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _WorkflowService_RunWorkflowId_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ResumeRun",
+			Handler:       _WorkflowService_ResumeRun_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DebugRun",
+			Handler:       _WorkflowService_DebugRun_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "workflow.proto",
 }
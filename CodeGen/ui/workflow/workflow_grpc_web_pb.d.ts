@@ -68,6 +68,51 @@ export class WorkflowServiceClient {
                response: workflow_pb.WorkflowHistoryResponse) => void
   ): grpcWeb.ClientReadableStream<workflow_pb.WorkflowHistoryResponse>;
 
+  cancelRun(
+    request: workflow_pb.CancelRunRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  resumeRun(
+    request: workflow_pb.ResumeRunRequest,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<workflow_pb.ReplayData>;
+
+  approveStep(
+    request: workflow_pb.StepDecisionRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  rejectStep(
+    request: workflow_pb.StepDecisionRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  validateWorkflow(
+    request: workflow_pb.Workflow,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: workflow_pb.ValidationResult) => void
+  ): grpcWeb.ClientReadableStream<workflow_pb.ValidationResult>;
+
+  debugRun(
+    request: workflow_pb.DebugRunRequest,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<workflow_pb.ReplayData>;
+
+  debugControl(
+    request: workflow_pb.DebugCommand,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
 }
 
 export class WorkflowServicePromiseClient {
@@ -120,5 +165,40 @@ export class WorkflowServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<workflow_pb.WorkflowHistoryResponse>;
 
+  cancelRun(
+    request: workflow_pb.CancelRunRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  resumeRun(
+    request: workflow_pb.ResumeRunRequest,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<workflow_pb.ReplayData>;
+
+  approveStep(
+    request: workflow_pb.StepDecisionRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  rejectStep(
+    request: workflow_pb.StepDecisionRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  validateWorkflow(
+    request: workflow_pb.Workflow,
+    metadata?: grpcWeb.Metadata
+  ): Promise<workflow_pb.ValidationResult>;
+
+  debugRun(
+    request: workflow_pb.DebugRunRequest,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<workflow_pb.ReplayData>;
+
+  debugControl(
+    request: workflow_pb.DebugCommand,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
 }
 
@@ -21,6 +21,8 @@ grpc.web = require('grpc-web');
 
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js')
+
+var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js')
 const proto = {};
 proto.proto = require('./workflow_pb.js');
 
@@ -615,5 +617,422 @@ proto.proto.WorkflowServicePromiseClient.prototype.getWorkflowHistory =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.proto.CancelRunRequest,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_WorkflowService_CancelRun = new grpc.web.MethodDescriptor(
+  '/proto.WorkflowService/CancelRun',
+  grpc.web.MethodType.UNARY,
+  proto.proto.CancelRunRequest,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.proto.CancelRunRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.proto.CancelRunRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServiceClient.prototype.cancelRun =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/proto.WorkflowService/CancelRun',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_CancelRun,
+      callback);
+};
+
+
+/**
+ * @param {!proto.proto.CancelRunRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.proto.WorkflowServicePromiseClient.prototype.cancelRun =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/proto.WorkflowService/CancelRun',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_CancelRun);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.proto.ResumeRunRequest,
+ *   !proto.proto.ReplayData>}
+ */
+const methodDescriptor_WorkflowService_ResumeRun = new grpc.web.MethodDescriptor(
+  '/proto.WorkflowService/ResumeRun',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.proto.ResumeRunRequest,
+  proto.proto.ReplayData,
+  /**
+   * @param {!proto.proto.ResumeRunRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.proto.ReplayData.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.proto.ResumeRunRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.proto.ReplayData>}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServiceClient.prototype.resumeRun =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/proto.WorkflowService/ResumeRun',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_ResumeRun);
+};
+
+
+/**
+ * @param {!proto.proto.ResumeRunRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.proto.ReplayData>}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServicePromiseClient.prototype.resumeRun =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/proto.WorkflowService/ResumeRun',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_ResumeRun);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.proto.StepDecisionRequest,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_WorkflowService_ApproveStep = new grpc.web.MethodDescriptor(
+  '/proto.WorkflowService/ApproveStep',
+  grpc.web.MethodType.UNARY,
+  proto.proto.StepDecisionRequest,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.proto.StepDecisionRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.proto.StepDecisionRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServiceClient.prototype.approveStep =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/proto.WorkflowService/ApproveStep',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_ApproveStep,
+      callback);
+};
+
+
+/**
+ * @param {!proto.proto.StepDecisionRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.proto.WorkflowServicePromiseClient.prototype.approveStep =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/proto.WorkflowService/ApproveStep',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_ApproveStep);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.proto.StepDecisionRequest,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_WorkflowService_RejectStep = new grpc.web.MethodDescriptor(
+  '/proto.WorkflowService/RejectStep',
+  grpc.web.MethodType.UNARY,
+  proto.proto.StepDecisionRequest,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.proto.StepDecisionRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.proto.StepDecisionRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServiceClient.prototype.rejectStep =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/proto.WorkflowService/RejectStep',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_RejectStep,
+      callback);
+};
+
+
+/**
+ * @param {!proto.proto.StepDecisionRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.proto.WorkflowServicePromiseClient.prototype.rejectStep =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/proto.WorkflowService/RejectStep',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_RejectStep);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.proto.Workflow,
+ *   !proto.proto.ValidationResult>}
+ */
+const methodDescriptor_WorkflowService_ValidateWorkflow = new grpc.web.MethodDescriptor(
+  '/proto.WorkflowService/ValidateWorkflow',
+  grpc.web.MethodType.UNARY,
+  proto.proto.Workflow,
+  proto.proto.ValidationResult,
+  /**
+   * @param {!proto.proto.Workflow} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.proto.ValidationResult.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.proto.Workflow} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.proto.ValidationResult)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.proto.ValidationResult>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServiceClient.prototype.validateWorkflow =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/proto.WorkflowService/ValidateWorkflow',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_ValidateWorkflow,
+      callback);
+};
+
+
+/**
+ * @param {!proto.proto.Workflow} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.proto.ValidationResult>}
+ *     Promise that resolves to the response
+ */
+proto.proto.WorkflowServicePromiseClient.prototype.validateWorkflow =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/proto.WorkflowService/ValidateWorkflow',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_ValidateWorkflow);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.proto.DebugRunRequest,
+ *   !proto.proto.ReplayData>}
+ */
+const methodDescriptor_WorkflowService_DebugRun = new grpc.web.MethodDescriptor(
+  '/proto.WorkflowService/DebugRun',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.proto.DebugRunRequest,
+  proto.proto.ReplayData,
+  /**
+   * @param {!proto.proto.DebugRunRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.proto.ReplayData.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.proto.DebugRunRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.proto.ReplayData>}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServiceClient.prototype.debugRun =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/proto.WorkflowService/DebugRun',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_DebugRun);
+};
+
+
+/**
+ * @param {!proto.proto.DebugRunRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.proto.ReplayData>}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServicePromiseClient.prototype.debugRun =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/proto.WorkflowService/DebugRun',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_DebugRun);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.proto.DebugCommand,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_WorkflowService_DebugControl = new grpc.web.MethodDescriptor(
+  '/proto.WorkflowService/DebugControl',
+  grpc.web.MethodType.UNARY,
+  proto.proto.DebugCommand,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.proto.DebugCommand} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.proto.DebugCommand} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.proto.WorkflowServiceClient.prototype.debugControl =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/proto.WorkflowService/DebugControl',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_DebugControl,
+      callback);
+};
+
+
+/**
+ * @param {!proto.proto.DebugCommand} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.proto.WorkflowServicePromiseClient.prototype.debugControl =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/proto.WorkflowService/DebugControl',
+      request,
+      metadata || {},
+      methodDescriptor_WorkflowService_DebugControl);
+};
+
+
 module.exports = proto.proto;
 
@@ -1,6 +1,7 @@
 import * as jspb from 'google-protobuf'
 
 import * as google_protobuf_empty_pb from 'google-protobuf/google/protobuf/empty_pb'; // proto import: "google/protobuf/empty.proto"
+import * as google_protobuf_struct_pb from 'google-protobuf/google/protobuf/struct_pb'; // proto import: "google/protobuf/struct.proto"
 
 
 export class RunWorkflowIdRequest extends jspb.Message {
@@ -21,6 +22,194 @@ export namespace RunWorkflowIdRequest {
   };
 }
 
+export class CancelRunRequest extends jspb.Message {
+  getRunid(): string;
+  setRunid(value: string): CancelRunRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CancelRunRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CancelRunRequest): CancelRunRequest.AsObject;
+  static serializeBinaryToWriter(message: CancelRunRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CancelRunRequest;
+  static deserializeBinaryFromReader(message: CancelRunRequest, reader: jspb.BinaryReader): CancelRunRequest;
+}
+
+export namespace CancelRunRequest {
+  export type AsObject = {
+    runid: string;
+  };
+}
+
+export class ResumeRunRequest extends jspb.Message {
+  getProcessid(): string;
+  setProcessid(value: string): ResumeRunRequest;
+
+  getFromnodeid(): string;
+  setFromnodeid(value: string): ResumeRunRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResumeRunRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ResumeRunRequest): ResumeRunRequest.AsObject;
+  static serializeBinaryToWriter(message: ResumeRunRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResumeRunRequest;
+  static deserializeBinaryFromReader(message: ResumeRunRequest, reader: jspb.BinaryReader): ResumeRunRequest;
+}
+
+export namespace ResumeRunRequest {
+  export type AsObject = {
+    processid: string;
+    fromnodeid: string;
+  };
+}
+
+export class DebugRunRequest extends jspb.Message {
+  getWorkflow(): Workflow | undefined;
+  setWorkflow(value?: Workflow): DebugRunRequest;
+  hasWorkflow(): boolean;
+  clearWorkflow(): DebugRunRequest;
+
+  getBreakpointsList(): Array<string>;
+  setBreakpointsList(value: Array<string>): DebugRunRequest;
+  clearBreakpointsList(): DebugRunRequest;
+  addBreakpoints(value: string, index?: number): DebugRunRequest;
+
+  getPauseatstart(): boolean;
+  setPauseatstart(value: boolean): DebugRunRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DebugRunRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: DebugRunRequest): DebugRunRequest.AsObject;
+  static serializeBinaryToWriter(message: DebugRunRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DebugRunRequest;
+  static deserializeBinaryFromReader(message: DebugRunRequest, reader: jspb.BinaryReader): DebugRunRequest;
+}
+
+export namespace DebugRunRequest {
+  export type AsObject = {
+    workflow?: Workflow.AsObject;
+    breakpointsList: Array<string>;
+    pauseatstart: boolean;
+  };
+}
+
+export class DebugCommand extends jspb.Message {
+  getProcessid(): string;
+  setProcessid(value: string): DebugCommand;
+
+  getAction(): string;
+  setAction(value: string): DebugCommand;
+
+  getBreakpointsList(): Array<string>;
+  setBreakpointsList(value: Array<string>): DebugCommand;
+  clearBreakpointsList(): DebugCommand;
+  addBreakpoints(value: string, index?: number): DebugCommand;
+
+  getVariables(): google_protobuf_struct_pb.Struct | undefined;
+  setVariables(value?: google_protobuf_struct_pb.Struct): DebugCommand;
+  hasVariables(): boolean;
+  clearVariables(): DebugCommand;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DebugCommand.AsObject;
+  static toObject(includeInstance: boolean, msg: DebugCommand): DebugCommand.AsObject;
+  static serializeBinaryToWriter(message: DebugCommand, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DebugCommand;
+  static deserializeBinaryFromReader(message: DebugCommand, reader: jspb.BinaryReader): DebugCommand;
+}
+
+export namespace DebugCommand {
+  export type AsObject = {
+    processid: string;
+    action: string;
+    breakpointsList: Array<string>;
+    variables?: google_protobuf_struct_pb.Struct.AsObject;
+  };
+}
+
+export class StepDecisionRequest extends jspb.Message {
+  getProcessid(): string;
+  setProcessid(value: string): StepDecisionRequest;
+
+  getNodeid(): string;
+  setNodeid(value: string): StepDecisionRequest;
+
+  getComment(): string;
+  setComment(value: string): StepDecisionRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StepDecisionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StepDecisionRequest): StepDecisionRequest.AsObject;
+  static serializeBinaryToWriter(message: StepDecisionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StepDecisionRequest;
+  static deserializeBinaryFromReader(message: StepDecisionRequest, reader: jspb.BinaryReader): StepDecisionRequest;
+}
+
+export namespace StepDecisionRequest {
+  export type AsObject = {
+    processid: string;
+    nodeid: string;
+    comment: string;
+  };
+}
+
+export class ValidationIssue extends jspb.Message {
+  getNodeid(): string;
+  setNodeid(value: string): ValidationIssue;
+
+  getEdgeid(): string;
+  setEdgeid(value: string): ValidationIssue;
+
+  getField(): string;
+  setField(value: string): ValidationIssue;
+
+  getSeverity(): string;
+  setSeverity(value: string): ValidationIssue;
+
+  getMessage(): string;
+  setMessage(value: string): ValidationIssue;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ValidationIssue.AsObject;
+  static toObject(includeInstance: boolean, msg: ValidationIssue): ValidationIssue.AsObject;
+  static serializeBinaryToWriter(message: ValidationIssue, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ValidationIssue;
+  static deserializeBinaryFromReader(message: ValidationIssue, reader: jspb.BinaryReader): ValidationIssue;
+}
+
+export namespace ValidationIssue {
+  export type AsObject = {
+    nodeid: string;
+    edgeid: string;
+    field: string;
+    severity: string;
+    message: string;
+  };
+}
+
+export class ValidationResult extends jspb.Message {
+  getValid(): boolean;
+  setValid(value: boolean): ValidationResult;
+
+  getIssuesList(): Array<ValidationIssue>;
+  setIssuesList(value: Array<ValidationIssue>): ValidationResult;
+  clearIssuesList(): ValidationResult;
+  addIssues(value?: ValidationIssue, index?: number): ValidationIssue;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ValidationResult.AsObject;
+  static toObject(includeInstance: boolean, msg: ValidationResult): ValidationResult.AsObject;
+  static serializeBinaryToWriter(message: ValidationResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ValidationResult;
+  static deserializeBinaryFromReader(message: ValidationResult, reader: jspb.BinaryReader): ValidationResult;
+}
+
+export namespace ValidationResult {
+  export type AsObject = {
+    valid: boolean;
+    issuesList: Array<ValidationIssue.AsObject>;
+  };
+}
+
 export class WorkflowHistoryList extends jspb.Message {
   getHistoryList(): Array<WorkflowHistory>;
   setHistoryList(value: Array<WorkflowHistory>): WorkflowHistoryList;
@@ -131,6 +320,14 @@ export class ReplayData extends jspb.Message {
   getMessage(): string;
   setMessage(value: string): ReplayData;
 
+  getProcessid(): string;
+  setProcessid(value: string): ReplayData;
+
+  getTypedvariables(): google_protobuf_struct_pb.Struct | undefined;
+  setTypedvariables(value?: google_protobuf_struct_pb.Struct): ReplayData;
+  hasTypedvariables(): boolean;
+  clearTypedvariables(): ReplayData;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ReplayData.AsObject;
   static toObject(includeInstance: boolean, msg: ReplayData): ReplayData.AsObject;
@@ -146,6 +343,8 @@ export namespace ReplayData {
     variablesMap: Array<[string, string]>;
     status: NodeStatus;
     message: string;
+    processid: string;
+    typedvariables?: google_protobuf_struct_pb.Struct.AsObject;
   };
 }
 
@@ -258,6 +457,11 @@ export class Workflow extends jspb.Message {
   hasIsnew(): boolean;
   clearIsnew(): Workflow;
 
+  getTimeoutms(): number;
+  setTimeoutms(value: number): Workflow;
+  hasTimeoutms(): boolean;
+  clearTimeoutms(): Workflow;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Workflow.AsObject;
   static toObject(includeInstance: boolean, msg: Workflow): Workflow.AsObject;
@@ -280,12 +484,18 @@ export namespace Workflow {
     updatedby: string;
     tagsList: Array<string>;
     isnew?: boolean;
+    timeoutms?: number;
   };
 
   export enum IsnewCase {
     _ISNEW_NOT_SET = 0,
     ISNEW = 12,
   }
+
+  export enum TimeoutmsCase {
+    _TIMEOUTMS_NOT_SET = 0,
+    TIMEOUTMS = 13,
+  }
 }
 
 export class Edge extends jspb.Message {
@@ -616,6 +826,71 @@ export class NodeData extends jspb.Message {
   hasWeeks(): boolean;
   clearWeeks(): NodeData;
 
+  getParallel(): boolean;
+  setParallel(value: boolean): NodeData;
+  hasParallel(): boolean;
+  clearParallel(): NodeData;
+
+  getConcurrency(): number;
+  setConcurrency(value: number): NodeData;
+  hasConcurrency(): boolean;
+  clearConcurrency(): NodeData;
+
+  getMode(): string;
+  setMode(value: string): NodeData;
+  hasMode(): boolean;
+  clearMode(): NodeData;
+
+  getRequired(): number;
+  setRequired(value: number): NodeData;
+  hasRequired(): boolean;
+  clearRequired(): NodeData;
+
+  getMergeas(): string;
+  setMergeas(value: string): NodeData;
+  hasMergeas(): boolean;
+  clearMergeas(): NodeData;
+
+  getRetry(): RetryPolicy | undefined;
+  setRetry(value?: RetryPolicy): NodeData;
+  hasRetry(): boolean;
+  clearRetry(): NodeData;
+
+  getTimeoutms(): number;
+  setTimeoutms(value: number): NodeData;
+  hasTimeoutms(): boolean;
+  clearTimeoutms(): NodeData;
+
+  getApprovers(): NodeDataArray | undefined;
+  setApprovers(value?: NodeDataArray): NodeData;
+  hasApprovers(): boolean;
+  clearApprovers(): NodeData;
+
+  getCases(): NodeDataArray | undefined;
+  setCases(value?: NodeDataArray): NodeData;
+  hasCases(): boolean;
+  clearCases(): NodeData;
+
+  getInputs(): NodeDataArray | undefined;
+  setInputs(value?: NodeDataArray): NodeData;
+  hasInputs(): boolean;
+  clearInputs(): NodeData;
+
+  getOutputs(): NodeDataArray | undefined;
+  setOutputs(value?: NodeDataArray): NodeData;
+  hasOutputs(): boolean;
+  clearOutputs(): NodeData;
+
+  getPlaintext(): boolean;
+  setPlaintext(value: boolean): NodeData;
+  hasPlaintext(): boolean;
+  clearPlaintext(): NodeData;
+
+  getParallelitems(): boolean;
+  setParallelitems(value: boolean): NodeData;
+  hasParallelitems(): boolean;
+  clearParallelitems(): NodeData;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NodeData.AsObject;
   static toObject(includeInstance: boolean, msg: NodeData): NodeData.AsObject;
@@ -648,6 +923,19 @@ export namespace NodeData {
     type?: string;
     interval?: number;
     weeks?: NodeDataArray.AsObject;
+    parallel?: boolean;
+    concurrency?: number;
+    mode?: string;
+    required?: number;
+    mergeas?: string;
+    retry?: RetryPolicy.AsObject;
+    timeoutms?: number;
+    approvers?: NodeDataArray.AsObject;
+    cases?: NodeDataArray.AsObject;
+    inputs?: NodeDataArray.AsObject;
+    outputs?: NodeDataArray.AsObject;
+    plaintext?: boolean;
+    parallelitems?: boolean;
   };
 
   export enum NameCase {
@@ -759,6 +1047,107 @@ export namespace NodeData {
     _WEEKS_NOT_SET = 0,
     WEEKS = 22,
   }
+
+  export enum ParallelCase {
+    _PARALLEL_NOT_SET = 0,
+    PARALLEL = 23,
+  }
+
+  export enum ConcurrencyCase {
+    _CONCURRENCY_NOT_SET = 0,
+    CONCURRENCY = 24,
+  }
+
+  export enum ModeCase {
+    _MODE_NOT_SET = 0,
+    MODE = 25,
+  }
+
+  export enum RequiredCase {
+    _REQUIRED_NOT_SET = 0,
+    REQUIRED = 26,
+  }
+
+  export enum MergeasCase {
+    _MERGEAS_NOT_SET = 0,
+    MERGEAS = 27,
+  }
+
+  export enum RetryCase {
+    _RETRY_NOT_SET = 0,
+    RETRY = 28,
+  }
+
+  export enum TimeoutmsCase {
+    _TIMEOUTMS_NOT_SET = 0,
+    TIMEOUTMS = 29,
+  }
+
+  export enum ApproversCase {
+    _APPROVERS_NOT_SET = 0,
+    APPROVERS = 30,
+  }
+
+  export enum CasesCase {
+    _CASES_NOT_SET = 0,
+    CASES = 31,
+  }
+
+  export enum InputsCase {
+    _INPUTS_NOT_SET = 0,
+    INPUTS = 32,
+  }
+
+  export enum OutputsCase {
+    _OUTPUTS_NOT_SET = 0,
+    OUTPUTS = 33,
+  }
+
+  export enum PlaintextCase {
+    _PLAINTEXT_NOT_SET = 0,
+    PLAINTEXT = 34,
+  }
+
+  export enum ParallelitemsCase {
+    _PARALLELITEMS_NOT_SET = 0,
+    PARALLELITEMS = 35,
+  }
+}
+
+export class RetryPolicy extends jspb.Message {
+  getMaxattempts(): number;
+  setMaxattempts(value: number): RetryPolicy;
+
+  getInitialdelayms(): number;
+  setInitialdelayms(value: number): RetryPolicy;
+
+  getMultiplier(): number;
+  setMultiplier(value: number): RetryPolicy;
+
+  getMaxdelayms(): number;
+  setMaxdelayms(value: number): RetryPolicy;
+
+  getRetryonList(): Array<string>;
+  setRetryonList(value: Array<string>): RetryPolicy;
+  clearRetryonList(): RetryPolicy;
+  addRetryon(value: string, index?: number): RetryPolicy;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RetryPolicy.AsObject;
+  static toObject(includeInstance: boolean, msg: RetryPolicy): RetryPolicy.AsObject;
+  static serializeBinaryToWriter(message: RetryPolicy, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RetryPolicy;
+  static deserializeBinaryFromReader(message: RetryPolicy, reader: jspb.BinaryReader): RetryPolicy;
+}
+
+export namespace RetryPolicy {
+  export type AsObject = {
+    maxattempts: number;
+    initialdelayms: number;
+    multiplier: number;
+    maxdelayms: number;
+    retryonList: Array<string>;
+  };
 }
 
 export class NodeDataArray extends jspb.Message {
@@ -985,4 +1374,7 @@ export enum NodeStatus {
   COMPLETED = 1,
   FAILED = 2,
   INFO = 3,
+  CANCELLED = 4,
+  WAITING = 5,
+  PAUSED = 6,
 }
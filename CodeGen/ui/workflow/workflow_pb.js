@@ -17,7 +17,12 @@ var global = globalThis;
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
+var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
+goog.object.extend(proto, google_protobuf_struct_pb);
 goog.exportSymbol('proto.proto.ArrayDataType', null, global);
+goog.exportSymbol('proto.proto.CancelRunRequest', null, global);
+goog.exportSymbol('proto.proto.DebugCommand', null, global);
+goog.exportSymbol('proto.proto.DebugRunRequest', null, global);
 goog.exportSymbol('proto.proto.Edge', null, global);
 goog.exportSymbol('proto.proto.GetWorkflowRequest', null, global);
 goog.exportSymbol('proto.proto.Handle', null, global);
@@ -32,7 +37,12 @@ goog.exportSymbol('proto.proto.NodePosition', null, global);
 goog.exportSymbol('proto.proto.NodeStatus', null, global);
 goog.exportSymbol('proto.proto.PageRequest', null, global);
 goog.exportSymbol('proto.proto.ReplayData', null, global);
+goog.exportSymbol('proto.proto.ResumeRunRequest', null, global);
+goog.exportSymbol('proto.proto.RetryPolicy', null, global);
 goog.exportSymbol('proto.proto.RunWorkflowIdRequest', null, global);
+goog.exportSymbol('proto.proto.StepDecisionRequest', null, global);
+goog.exportSymbol('proto.proto.ValidationIssue', null, global);
+goog.exportSymbol('proto.proto.ValidationResult', null, global);
 goog.exportSymbol('proto.proto.Workflow', null, global);
 goog.exportSymbol('proto.proto.WorkflowHistory', null, global);
 goog.exportSymbol('proto.proto.WorkflowHistoryList', null, global);
@@ -60,6 +70,153 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.proto.RunWorkflowIdRequest.displayName = 'proto.proto.RunWorkflowIdRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.proto.CancelRunRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.proto.CancelRunRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.proto.CancelRunRequest.displayName = 'proto.proto.CancelRunRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.proto.ResumeRunRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.proto.ResumeRunRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.proto.ResumeRunRequest.displayName = 'proto.proto.ResumeRunRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.proto.DebugRunRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.proto.DebugRunRequest.repeatedFields_, null);
+};
+goog.inherits(proto.proto.DebugRunRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.proto.DebugRunRequest.displayName = 'proto.proto.DebugRunRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.proto.DebugCommand = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.proto.DebugCommand.repeatedFields_, null);
+};
+goog.inherits(proto.proto.DebugCommand, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.proto.DebugCommand.displayName = 'proto.proto.DebugCommand';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.proto.StepDecisionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.proto.StepDecisionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.proto.StepDecisionRequest.displayName = 'proto.proto.StepDecisionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.proto.ValidationIssue = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.proto.ValidationIssue, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.proto.ValidationIssue.displayName = 'proto.proto.ValidationIssue';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.proto.ValidationResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.proto.ValidationResult.repeatedFields_, null);
+};
+goog.inherits(proto.proto.ValidationResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.proto.ValidationResult.displayName = 'proto.proto.ValidationResult';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -312,6 +469,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.proto.NodeData.displayName = 'proto.proto.NodeData';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.proto.RetryPolicy = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.proto.RetryPolicy.repeatedFields_, null);
+};
+goog.inherits(proto.proto.RetryPolicy, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.proto.RetryPolicy.displayName = 'proto.proto.RetryPolicy';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -590,13 +768,6 @@ proto.proto.RunWorkflowIdRequest.prototype.setId = function(value) {
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.proto.WorkflowHistoryList.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -612,8 +783,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.WorkflowHistoryList.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.WorkflowHistoryList.toObject(opt_includeInstance, this);
+proto.proto.CancelRunRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.CancelRunRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -622,14 +793,13 @@ proto.proto.WorkflowHistoryList.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.WorkflowHistoryList} msg The msg instance to transform.
+ * @param {!proto.proto.CancelRunRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowHistoryList.toObject = function(includeInstance, msg) {
+proto.proto.CancelRunRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-historyList: jspb.Message.toObjectList(msg.getHistoryList(),
-    proto.proto.WorkflowHistory.toObject, includeInstance)
+runid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -643,23 +813,23 @@ historyList: jspb.Message.toObjectList(msg.getHistoryList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.WorkflowHistoryList}
+ * @return {!proto.proto.CancelRunRequest}
  */
-proto.proto.WorkflowHistoryList.deserializeBinary = function(bytes) {
+proto.proto.CancelRunRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.WorkflowHistoryList;
-  return proto.proto.WorkflowHistoryList.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.CancelRunRequest;
+  return proto.proto.CancelRunRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.WorkflowHistoryList} msg The message object to deserialize into.
+ * @param {!proto.proto.CancelRunRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.WorkflowHistoryList}
+ * @return {!proto.proto.CancelRunRequest}
  */
-proto.proto.WorkflowHistoryList.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.CancelRunRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -667,9 +837,8 @@ proto.proto.WorkflowHistoryList.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.proto.WorkflowHistory;
-      reader.readMessage(value,proto.proto.WorkflowHistory.deserializeBinaryFromReader);
-      msg.addHistory(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setRunid(value);
       break;
     default:
       reader.skipField();
@@ -684,9 +853,9 @@ proto.proto.WorkflowHistoryList.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.WorkflowHistoryList.prototype.serializeBinary = function() {
+proto.proto.CancelRunRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.WorkflowHistoryList.serializeBinaryToWriter(this, writer);
+  proto.proto.CancelRunRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -694,58 +863,37 @@ proto.proto.WorkflowHistoryList.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.WorkflowHistoryList} message
+ * @param {!proto.proto.CancelRunRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowHistoryList.serializeBinaryToWriter = function(message, writer) {
+proto.proto.CancelRunRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHistoryList();
+  f = message.getRunid();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.proto.WorkflowHistory.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated WorkflowHistory history = 1;
- * @return {!Array<!proto.proto.WorkflowHistory>}
- */
-proto.proto.WorkflowHistoryList.prototype.getHistoryList = function() {
-  return /** @type{!Array<!proto.proto.WorkflowHistory>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.proto.WorkflowHistory, 1));
-};
-
-
-/**
- * @param {!Array<!proto.proto.WorkflowHistory>} value
- * @return {!proto.proto.WorkflowHistoryList} returns this
-*/
-proto.proto.WorkflowHistoryList.prototype.setHistoryList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.proto.WorkflowHistory=} opt_value
- * @param {number=} opt_index
- * @return {!proto.proto.WorkflowHistory}
+ * optional string runId = 1;
+ * @return {string}
  */
-proto.proto.WorkflowHistoryList.prototype.addHistory = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.proto.WorkflowHistory, opt_index);
+proto.proto.CancelRunRequest.prototype.getRunid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.proto.WorkflowHistoryList} returns this
+ * @param {string} value
+ * @return {!proto.proto.CancelRunRequest} returns this
  */
-proto.proto.WorkflowHistoryList.prototype.clearHistoryList = function() {
-  return this.setHistoryList([]);
+proto.proto.CancelRunRequest.prototype.setRunid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -765,8 +913,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.WorkflowHistory.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.WorkflowHistory.toObject(opt_includeInstance, this);
+proto.proto.ResumeRunRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.ResumeRunRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -775,17 +923,14 @@ proto.proto.WorkflowHistory.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.WorkflowHistory} msg The msg instance to transform.
+ * @param {!proto.proto.ResumeRunRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowHistory.toObject = function(includeInstance, msg) {
+proto.proto.ResumeRunRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-workflowid: jspb.Message.getFieldWithDefault(msg, 2, ""),
-workflowname: jspb.Message.getFieldWithDefault(msg, 3, ""),
-rundate: jspb.Message.getFieldWithDefault(msg, 4, ""),
-status: jspb.Message.getFieldWithDefault(msg, 5, 0)
+processid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+fromnodeid: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -799,23 +944,23 @@ status: jspb.Message.getFieldWithDefault(msg, 5, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.WorkflowHistory}
+ * @return {!proto.proto.ResumeRunRequest}
  */
-proto.proto.WorkflowHistory.deserializeBinary = function(bytes) {
+proto.proto.ResumeRunRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.WorkflowHistory;
-  return proto.proto.WorkflowHistory.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.ResumeRunRequest;
+  return proto.proto.ResumeRunRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.WorkflowHistory} msg The message object to deserialize into.
+ * @param {!proto.proto.ResumeRunRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.WorkflowHistory}
+ * @return {!proto.proto.ResumeRunRequest}
  */
-proto.proto.WorkflowHistory.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.ResumeRunRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -824,23 +969,11 @@ proto.proto.WorkflowHistory.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setId(value);
+      msg.setProcessid(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setWorkflowid(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setWorkflowname(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setRundate(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.proto.NodeStatus} */ (reader.readEnum());
-      msg.setStatus(value);
+      msg.setFromnodeid(value);
       break;
     default:
       reader.skipField();
@@ -855,9 +988,9 @@ proto.proto.WorkflowHistory.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.WorkflowHistory.prototype.serializeBinary = function() {
+proto.proto.ResumeRunRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.WorkflowHistory.serializeBinaryToWriter(this, writer);
+  proto.proto.ResumeRunRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -865,140 +998,72 @@ proto.proto.WorkflowHistory.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.WorkflowHistory} message
+ * @param {!proto.proto.ResumeRunRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowHistory.serializeBinaryToWriter = function(message, writer) {
+proto.proto.ResumeRunRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getProcessid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getWorkflowid();
+  f = message.getFromnodeid();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getWorkflowname();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getRundate();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string Id = 1;
+ * optional string processId = 1;
  * @return {string}
  */
-proto.proto.WorkflowHistory.prototype.getId = function() {
+proto.proto.ResumeRunRequest.prototype.getProcessid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.WorkflowHistory} returns this
+ * @return {!proto.proto.ResumeRunRequest} returns this
  */
-proto.proto.WorkflowHistory.prototype.setId = function(value) {
+proto.proto.ResumeRunRequest.prototype.setProcessid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string WorkflowId = 2;
+ * optional string fromNodeId = 2;
  * @return {string}
  */
-proto.proto.WorkflowHistory.prototype.getWorkflowid = function() {
+proto.proto.ResumeRunRequest.prototype.getFromnodeid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.WorkflowHistory} returns this
+ * @return {!proto.proto.ResumeRunRequest} returns this
  */
-proto.proto.WorkflowHistory.prototype.setWorkflowid = function(value) {
+proto.proto.ResumeRunRequest.prototype.setFromnodeid = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * optional string WorkflowName = 3;
- * @return {string}
- */
-proto.proto.WorkflowHistory.prototype.getWorkflowname = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.proto.WorkflowHistory} returns this
- */
-proto.proto.WorkflowHistory.prototype.setWorkflowname = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string RunDate = 4;
- * @return {string}
- */
-proto.proto.WorkflowHistory.prototype.getRundate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.proto.WorkflowHistory} returns this
- */
-proto.proto.WorkflowHistory.prototype.setRundate = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional NodeStatus Status = 5;
- * @return {!proto.proto.NodeStatus}
- */
-proto.proto.WorkflowHistory.prototype.getStatus = function() {
-  return /** @type {!proto.proto.NodeStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
 
 /**
- * @param {!proto.proto.NodeStatus} value
- * @return {!proto.proto.WorkflowHistory} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.proto.WorkflowHistory.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
-};
-
-
+proto.proto.DebugRunRequest.repeatedFields_ = [2];
 
 
 
@@ -1015,8 +1080,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.WorkflowHistoryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.WorkflowHistoryRequest.toObject(opt_includeInstance, this);
+proto.proto.DebugRunRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.DebugRunRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1025,13 +1090,15 @@ proto.proto.WorkflowHistoryRequest.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.WorkflowHistoryRequest} msg The msg instance to transform.
+ * @param {!proto.proto.DebugRunRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowHistoryRequest.toObject = function(includeInstance, msg) {
+proto.proto.DebugRunRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, "")
+workflow: (f = msg.getWorkflow()) && proto.proto.Workflow.toObject(includeInstance, f),
+breakpointsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+pauseatstart: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -1045,23 +1112,23 @@ id: jspb.Message.getFieldWithDefault(msg, 1, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.WorkflowHistoryRequest}
+ * @return {!proto.proto.DebugRunRequest}
  */
-proto.proto.WorkflowHistoryRequest.deserializeBinary = function(bytes) {
+proto.proto.DebugRunRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.WorkflowHistoryRequest;
-  return proto.proto.WorkflowHistoryRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.DebugRunRequest;
+  return proto.proto.DebugRunRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.WorkflowHistoryRequest} msg The message object to deserialize into.
+ * @param {!proto.proto.DebugRunRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.WorkflowHistoryRequest}
+ * @return {!proto.proto.DebugRunRequest}
  */
-proto.proto.WorkflowHistoryRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.DebugRunRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1069,8 +1136,17 @@ proto.proto.WorkflowHistoryRequest.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = new proto.proto.Workflow;
+      reader.readMessage(value,proto.proto.Workflow.deserializeBinaryFromReader);
+      msg.setWorkflow(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setId(value);
+      msg.addBreakpoints(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPauseatstart(value);
       break;
     default:
       reader.skipField();
@@ -1085,9 +1161,9 @@ proto.proto.WorkflowHistoryRequest.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.WorkflowHistoryRequest.prototype.serializeBinary = function() {
+proto.proto.DebugRunRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.WorkflowHistoryRequest.serializeBinaryToWriter(this, writer);
+  proto.proto.DebugRunRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1095,16 +1171,31 @@ proto.proto.WorkflowHistoryRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.WorkflowHistoryRequest} message
+ * @param {!proto.proto.DebugRunRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowHistoryRequest.serializeBinaryToWriter = function(message, writer) {
+proto.proto.DebugRunRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getWorkflow();
+  if (f != null) {
+    writer.writeMessage(
       1,
+      f,
+      proto.proto.Workflow.serializeBinaryToWriter
+    );
+  }
+  f = message.getBreakpointsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getPauseatstart();
+  if (f) {
+    writer.writeBool(
+      3,
       f
     );
   }
@@ -1112,20 +1203,94 @@ proto.proto.WorkflowHistoryRequest.serializeBinaryToWriter = function(message, w
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional Workflow workflow = 1;
+ * @return {?proto.proto.Workflow}
  */
-proto.proto.WorkflowHistoryRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.proto.DebugRunRequest.prototype.getWorkflow = function() {
+  return /** @type{?proto.proto.Workflow} */ (
+    jspb.Message.getWrapperField(this, proto.proto.Workflow, 1));
+};
+
+
+/**
+ * @param {?proto.proto.Workflow|undefined} value
+ * @return {!proto.proto.DebugRunRequest} returns this
+*/
+proto.proto.DebugRunRequest.prototype.setWorkflow = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.DebugRunRequest} returns this
+ */
+proto.proto.DebugRunRequest.prototype.clearWorkflow = function() {
+  return this.setWorkflow(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.DebugRunRequest.prototype.hasWorkflow = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated string breakpoints = 2;
+ * @return {!Array<string>}
+ */
+proto.proto.DebugRunRequest.prototype.getBreakpointsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.proto.DebugRunRequest} returns this
+ */
+proto.proto.DebugRunRequest.prototype.setBreakpointsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.WorkflowHistoryRequest} returns this
+ * @param {number=} opt_index
+ * @return {!proto.proto.DebugRunRequest} returns this
  */
-proto.proto.WorkflowHistoryRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.proto.DebugRunRequest.prototype.addBreakpoints = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.DebugRunRequest} returns this
+ */
+proto.proto.DebugRunRequest.prototype.clearBreakpointsList = function() {
+  return this.setBreakpointsList([]);
+};
+
+
+/**
+ * optional bool pauseAtStart = 3;
+ * @return {boolean}
+ */
+proto.proto.DebugRunRequest.prototype.getPauseatstart = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.proto.DebugRunRequest} returns this
+ */
+proto.proto.DebugRunRequest.prototype.setPauseatstart = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -1135,7 +1300,7 @@ proto.proto.WorkflowHistoryRequest.prototype.setId = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.proto.WorkflowHistoryResponse.repeatedFields_ = [1];
+proto.proto.DebugCommand.repeatedFields_ = [3];
 
 
 
@@ -1152,8 +1317,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.WorkflowHistoryResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.WorkflowHistoryResponse.toObject(opt_includeInstance, this);
+proto.proto.DebugCommand.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.DebugCommand.toObject(opt_includeInstance, this);
 };
 
 
@@ -1162,14 +1327,16 @@ proto.proto.WorkflowHistoryResponse.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.WorkflowHistoryResponse} msg The msg instance to transform.
+ * @param {!proto.proto.DebugCommand} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowHistoryResponse.toObject = function(includeInstance, msg) {
+proto.proto.DebugCommand.toObject = function(includeInstance, msg) {
   var f, obj = {
-dataList: jspb.Message.toObjectList(msg.getDataList(),
-    proto.proto.ReplayData.toObject, includeInstance)
+processid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+action: jspb.Message.getFieldWithDefault(msg, 2, ""),
+breakpointsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+variables: (f = msg.getVariables()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1183,23 +1350,23 @@ dataList: jspb.Message.toObjectList(msg.getDataList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.WorkflowHistoryResponse}
+ * @return {!proto.proto.DebugCommand}
  */
-proto.proto.WorkflowHistoryResponse.deserializeBinary = function(bytes) {
+proto.proto.DebugCommand.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.WorkflowHistoryResponse;
-  return proto.proto.WorkflowHistoryResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.DebugCommand;
+  return proto.proto.DebugCommand.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.WorkflowHistoryResponse} msg The message object to deserialize into.
+ * @param {!proto.proto.DebugCommand} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.WorkflowHistoryResponse}
+ * @return {!proto.proto.DebugCommand}
  */
-proto.proto.WorkflowHistoryResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.DebugCommand.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1207,9 +1374,21 @@ proto.proto.WorkflowHistoryResponse.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.proto.ReplayData;
-      reader.readMessage(value,proto.proto.ReplayData.deserializeBinaryFromReader);
-      msg.addData(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setProcessid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setAction(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addBreakpoints(value);
+      break;
+    case 4:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setVariables(value);
       break;
     default:
       reader.skipField();
@@ -1224,9 +1403,9 @@ proto.proto.WorkflowHistoryResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.WorkflowHistoryResponse.prototype.serializeBinary = function() {
+proto.proto.DebugCommand.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.WorkflowHistoryResponse.serializeBinaryToWriter(this, writer);
+  proto.proto.DebugCommand.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1234,67 +1413,160 @@ proto.proto.WorkflowHistoryResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.WorkflowHistoryResponse} message
+ * @param {!proto.proto.DebugCommand} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowHistoryResponse.serializeBinaryToWriter = function(message, writer) {
+proto.proto.DebugCommand.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDataList();
+  f = message.getProcessid();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
+      f
+    );
+  }
+  f = message.getAction();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getBreakpointsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getVariables();
+  if (f != null) {
+    writer.writeMessage(
+      4,
       f,
-      proto.proto.ReplayData.serializeBinaryToWriter
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated ReplayData data = 1;
- * @return {!Array<!proto.proto.ReplayData>}
+ * optional string processId = 1;
+ * @return {string}
  */
-proto.proto.WorkflowHistoryResponse.prototype.getDataList = function() {
-  return /** @type{!Array<!proto.proto.ReplayData>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.proto.ReplayData, 1));
+proto.proto.DebugCommand.prototype.getProcessid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.proto.ReplayData>} value
- * @return {!proto.proto.WorkflowHistoryResponse} returns this
-*/
-proto.proto.WorkflowHistoryResponse.prototype.setDataList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.proto.DebugCommand} returns this
+ */
+proto.proto.DebugCommand.prototype.setProcessid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.proto.ReplayData=} opt_value
- * @param {number=} opt_index
- * @return {!proto.proto.ReplayData}
+ * optional string action = 2;
+ * @return {string}
  */
-proto.proto.WorkflowHistoryResponse.prototype.addData = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.proto.ReplayData, opt_index);
+proto.proto.DebugCommand.prototype.getAction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.proto.WorkflowHistoryResponse} returns this
+ * @param {string} value
+ * @return {!proto.proto.DebugCommand} returns this
  */
-proto.proto.WorkflowHistoryResponse.prototype.clearDataList = function() {
-  return this.setDataList([]);
+proto.proto.DebugCommand.prototype.setAction = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * repeated string breakpoints = 3;
+ * @return {!Array<string>}
+ */
+proto.proto.DebugCommand.prototype.getBreakpointsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
+ * @param {!Array<string>} value
+ * @return {!proto.proto.DebugCommand} returns this
+ */
+proto.proto.DebugCommand.prototype.setBreakpointsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.proto.DebugCommand} returns this
+ */
+proto.proto.DebugCommand.prototype.addBreakpoints = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.DebugCommand} returns this
+ */
+proto.proto.DebugCommand.prototype.clearBreakpointsList = function() {
+  return this.setBreakpointsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Struct variables = 4;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.proto.DebugCommand.prototype.getVariables = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.proto.DebugCommand} returns this
+*/
+proto.proto.DebugCommand.prototype.setVariables = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.DebugCommand} returns this
+ */
+proto.proto.DebugCommand.prototype.clearVariables = function() {
+  return this.setVariables(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.DebugCommand.prototype.hasVariables = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
  * Field names that are reserved in JavaScript and will be renamed to pb_name.
  * Optional fields that are not set will be set to undefined.
  * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
@@ -1305,8 +1577,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.ReplayData.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.ReplayData.toObject(opt_includeInstance, this);
+proto.proto.StepDecisionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.StepDecisionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1315,17 +1587,15 @@ proto.proto.ReplayData.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.ReplayData} msg The msg instance to transform.
+ * @param {!proto.proto.StepDecisionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.ReplayData.toObject = function(includeInstance, msg) {
+proto.proto.StepDecisionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-nodeid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-data: (f = msg.getData()) && proto.proto.NodeData.toObject(includeInstance, f),
-variablesMap: (f = msg.getVariablesMap()) ? f.toObject(includeInstance, undefined) : [],
-status: jspb.Message.getFieldWithDefault(msg, 4, 0),
-message: jspb.Message.getFieldWithDefault(msg, 5, "")
+processid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+nodeid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+comment: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -1339,23 +1609,23 @@ message: jspb.Message.getFieldWithDefault(msg, 5, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.ReplayData}
+ * @return {!proto.proto.StepDecisionRequest}
  */
-proto.proto.ReplayData.deserializeBinary = function(bytes) {
+proto.proto.StepDecisionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.ReplayData;
-  return proto.proto.ReplayData.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.StepDecisionRequest;
+  return proto.proto.StepDecisionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.ReplayData} msg The message object to deserialize into.
+ * @param {!proto.proto.StepDecisionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.ReplayData}
+ * @return {!proto.proto.StepDecisionRequest}
  */
-proto.proto.ReplayData.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.StepDecisionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1364,26 +1634,15 @@ proto.proto.ReplayData.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setNodeid(value);
+      msg.setProcessid(value);
       break;
     case 2:
-      var value = new proto.proto.NodeData;
-      reader.readMessage(value,proto.proto.NodeData.deserializeBinaryFromReader);
-      msg.setData(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setNodeid(value);
       break;
     case 3:
-      var value = msg.getVariablesMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readStringRequireUtf8, jspb.BinaryReader.prototype.readStringRequireUtf8, null, "", "");
-         });
-      break;
-    case 4:
-      var value = /** @type {!proto.proto.NodeStatus} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 5:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
+      msg.setComment(value);
       break;
     default:
       reader.skipField();
@@ -1398,9 +1657,9 @@ proto.proto.ReplayData.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.ReplayData.prototype.serializeBinary = function() {
+proto.proto.StepDecisionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.ReplayData.serializeBinaryToWriter(this, writer);
+  proto.proto.StepDecisionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1408,47 +1667,30 @@ proto.proto.ReplayData.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.ReplayData} message
+ * @param {!proto.proto.StepDecisionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.ReplayData.serializeBinaryToWriter = function(message, writer) {
+proto.proto.StepDecisionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getNodeid();
+  f = message.getProcessid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getData();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getNodeid();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.proto.NodeData.serializeBinaryToWriter
-    );
-  }
-  f = message.getVariablesMap(true);
-  if (f && f.getLength() > 0) {
-jspb.internal.public_for_gencode.serializeMapToBinary(
-    message.getVariablesMap(true),
-    3,
-    writer,
-    jspb.BinaryWriter.prototype.writeString,
-    jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      4,
       f
     );
   }
-  f = message.getMessage();
+  f = message.getComment();
   if (f.length > 0) {
     writer.writeString(
-      5,
+      3,
       f
     );
   }
@@ -1456,116 +1698,56 @@ jspb.internal.public_for_gencode.serializeMapToBinary(
 
 
 /**
- * optional string nodeId = 1;
+ * optional string processId = 1;
  * @return {string}
  */
-proto.proto.ReplayData.prototype.getNodeid = function() {
+proto.proto.StepDecisionRequest.prototype.getProcessid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.ReplayData} returns this
+ * @return {!proto.proto.StepDecisionRequest} returns this
  */
-proto.proto.ReplayData.prototype.setNodeid = function(value) {
+proto.proto.StepDecisionRequest.prototype.setProcessid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional NodeData data = 2;
- * @return {?proto.proto.NodeData}
- */
-proto.proto.ReplayData.prototype.getData = function() {
-  return /** @type{?proto.proto.NodeData} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodeData, 2));
-};
-
-
-/**
- * @param {?proto.proto.NodeData|undefined} value
- * @return {!proto.proto.ReplayData} returns this
-*/
-proto.proto.ReplayData.prototype.setData = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.proto.ReplayData} returns this
- */
-proto.proto.ReplayData.prototype.clearData = function() {
-  return this.setData(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.proto.ReplayData.prototype.hasData = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * map<string, string> variables = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
- */
-proto.proto.ReplayData.prototype.getVariablesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      null));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.proto.ReplayData} returns this
- */
-proto.proto.ReplayData.prototype.clearVariablesMap = function() {
-  this.getVariablesMap().clear();
-  return this;
-};
-
-
-/**
- * optional NodeStatus status = 4;
- * @return {!proto.proto.NodeStatus}
+ * optional string nodeId = 2;
+ * @return {string}
  */
-proto.proto.ReplayData.prototype.getStatus = function() {
-  return /** @type {!proto.proto.NodeStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.proto.StepDecisionRequest.prototype.getNodeid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.proto.NodeStatus} value
- * @return {!proto.proto.ReplayData} returns this
+ * @param {string} value
+ * @return {!proto.proto.StepDecisionRequest} returns this
  */
-proto.proto.ReplayData.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.proto.StepDecisionRequest.prototype.setNodeid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string message = 5;
+ * optional string comment = 3;
  * @return {string}
  */
-proto.proto.ReplayData.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.proto.StepDecisionRequest.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.ReplayData} returns this
+ * @return {!proto.proto.StepDecisionRequest} returns this
  */
-proto.proto.ReplayData.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.proto.StepDecisionRequest.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -1585,8 +1767,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.PageRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.PageRequest.toObject(opt_includeInstance, this);
+proto.proto.ValidationIssue.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.ValidationIssue.toObject(opt_includeInstance, this);
 };
 
 
@@ -1595,14 +1777,17 @@ proto.proto.PageRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.PageRequest} msg The msg instance to transform.
+ * @param {!proto.proto.ValidationIssue} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.PageRequest.toObject = function(includeInstance, msg) {
+proto.proto.ValidationIssue.toObject = function(includeInstance, msg) {
   var f, obj = {
-limit: jspb.Message.getFieldWithDefault(msg, 1, 0),
-offset: jspb.Message.getFieldWithDefault(msg, 2, 0)
+nodeid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+edgeid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+field: jspb.Message.getFieldWithDefault(msg, 3, ""),
+severity: jspb.Message.getFieldWithDefault(msg, 4, ""),
+message: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -1616,23 +1801,23 @@ offset: jspb.Message.getFieldWithDefault(msg, 2, 0)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.PageRequest}
+ * @return {!proto.proto.ValidationIssue}
  */
-proto.proto.PageRequest.deserializeBinary = function(bytes) {
+proto.proto.ValidationIssue.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.PageRequest;
-  return proto.proto.PageRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.ValidationIssue;
+  return proto.proto.ValidationIssue.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.PageRequest} msg The message object to deserialize into.
+ * @param {!proto.proto.ValidationIssue} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.PageRequest}
+ * @return {!proto.proto.ValidationIssue}
  */
-proto.proto.PageRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.ValidationIssue.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1640,12 +1825,24 @@ proto.proto.PageRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLimit(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setNodeid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setOffset(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setEdgeid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setField(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSeverity(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
       break;
     default:
       reader.skipField();
@@ -1660,9 +1857,9 @@ proto.proto.PageRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.PageRequest.prototype.serializeBinary = function() {
+proto.proto.ValidationIssue.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.PageRequest.serializeBinaryToWriter(this, writer);
+  proto.proto.ValidationIssue.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1670,192 +1867,137 @@ proto.proto.PageRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.PageRequest} message
+ * @param {!proto.proto.ValidationIssue} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.PageRequest.serializeBinaryToWriter = function(message, writer) {
+proto.proto.ValidationIssue.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLimit();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getNodeid();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getOffset();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getEdgeid();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSeverity();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional int32 limit = 1;
- * @return {number}
- */
-proto.proto.PageRequest.prototype.getLimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.proto.PageRequest} returns this
- */
-proto.proto.PageRequest.prototype.setLimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional int32 offset = 2;
- * @return {number}
+ * optional string nodeId = 1;
+ * @return {string}
  */
-proto.proto.PageRequest.prototype.getOffset = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.proto.ValidationIssue.prototype.getNodeid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.proto.PageRequest} returns this
+ * @param {string} value
+ * @return {!proto.proto.ValidationIssue} returns this
  */
-proto.proto.PageRequest.prototype.setOffset = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.proto.ValidationIssue.prototype.setNodeid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string edgeId = 2;
+ * @return {string}
  */
-proto.proto.GetWorkflowRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.GetWorkflowRequest.toObject(opt_includeInstance, this);
+proto.proto.ValidationIssue.prototype.getEdgeid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.proto.GetWorkflowRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.proto.ValidationIssue} returns this
  */
-proto.proto.GetWorkflowRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.proto.ValidationIssue.prototype.setEdgeid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.GetWorkflowRequest}
+ * optional string field = 3;
+ * @return {string}
  */
-proto.proto.GetWorkflowRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.GetWorkflowRequest;
-  return proto.proto.GetWorkflowRequest.deserializeBinaryFromReader(msg, reader);
+proto.proto.ValidationIssue.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.proto.GetWorkflowRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.GetWorkflowRequest}
+ * @param {string} value
+ * @return {!proto.proto.ValidationIssue} returns this
  */
-proto.proto.GetWorkflowRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setId(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.proto.ValidationIssue.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string severity = 4;
+ * @return {string}
  */
-proto.proto.GetWorkflowRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.proto.GetWorkflowRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.proto.ValidationIssue.prototype.getSeverity = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.proto.GetWorkflowRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.proto.ValidationIssue} returns this
  */
-proto.proto.GetWorkflowRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.proto.ValidationIssue.prototype.setSeverity = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional string id = 1;
+ * optional string message = 5;
  * @return {string}
  */
-proto.proto.GetWorkflowRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.proto.ValidationIssue.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.GetWorkflowRequest} returns this
+ * @return {!proto.proto.ValidationIssue} returns this
  */
-proto.proto.GetWorkflowRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.proto.ValidationIssue.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
@@ -1865,7 +2007,7 @@ proto.proto.GetWorkflowRequest.prototype.setId = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.proto.WorkflowList.repeatedFields_ = [2];
+proto.proto.ValidationResult.repeatedFields_ = [2];
 
 
 
@@ -1882,8 +2024,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.WorkflowList.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.WorkflowList.toObject(opt_includeInstance, this);
+proto.proto.ValidationResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.ValidationResult.toObject(opt_includeInstance, this);
 };
 
 
@@ -1892,15 +2034,15 @@ proto.proto.WorkflowList.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.WorkflowList} msg The msg instance to transform.
+ * @param {!proto.proto.ValidationResult} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowList.toObject = function(includeInstance, msg) {
+proto.proto.ValidationResult.toObject = function(includeInstance, msg) {
   var f, obj = {
-total: jspb.Message.getFieldWithDefault(msg, 1, 0),
-workflowsList: jspb.Message.toObjectList(msg.getWorkflowsList(),
-    proto.proto.Workflow.toObject, includeInstance)
+valid: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+issuesList: jspb.Message.toObjectList(msg.getIssuesList(),
+    proto.proto.ValidationIssue.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1914,23 +2056,23 @@ workflowsList: jspb.Message.toObjectList(msg.getWorkflowsList(),
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.WorkflowList}
+ * @return {!proto.proto.ValidationResult}
  */
-proto.proto.WorkflowList.deserializeBinary = function(bytes) {
+proto.proto.ValidationResult.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.WorkflowList;
-  return proto.proto.WorkflowList.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.ValidationResult;
+  return proto.proto.ValidationResult.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.WorkflowList} msg The message object to deserialize into.
+ * @param {!proto.proto.ValidationResult} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.WorkflowList}
+ * @return {!proto.proto.ValidationResult}
  */
-proto.proto.WorkflowList.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.ValidationResult.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1938,13 +2080,13 @@ proto.proto.WorkflowList.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setTotal(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setValid(value);
       break;
     case 2:
-      var value = new proto.proto.Workflow;
-      reader.readMessage(value,proto.proto.Workflow.deserializeBinaryFromReader);
-      msg.addWorkflows(value);
+      var value = new proto.proto.ValidationIssue;
+      reader.readMessage(value,proto.proto.ValidationIssue.deserializeBinaryFromReader);
+      msg.addIssues(value);
       break;
     default:
       reader.skipField();
@@ -1959,9 +2101,9 @@ proto.proto.WorkflowList.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.WorkflowList.prototype.serializeBinary = function() {
+proto.proto.ValidationResult.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.WorkflowList.serializeBinaryToWriter(this, writer);
+  proto.proto.ValidationResult.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1969,83 +2111,83 @@ proto.proto.WorkflowList.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.WorkflowList} message
+ * @param {!proto.proto.ValidationResult} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.WorkflowList.serializeBinaryToWriter = function(message, writer) {
+proto.proto.ValidationResult.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTotal();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getValid();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
   }
-  f = message.getWorkflowsList();
+  f = message.getIssuesList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       2,
       f,
-      proto.proto.Workflow.serializeBinaryToWriter
+      proto.proto.ValidationIssue.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional int32 total = 1;
- * @return {number}
+ * optional bool valid = 1;
+ * @return {boolean}
  */
-proto.proto.WorkflowList.prototype.getTotal = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.proto.ValidationResult.prototype.getValid = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.proto.WorkflowList} returns this
+ * @param {boolean} value
+ * @return {!proto.proto.ValidationResult} returns this
  */
-proto.proto.WorkflowList.prototype.setTotal = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.proto.ValidationResult.prototype.setValid = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * repeated Workflow workflows = 2;
- * @return {!Array<!proto.proto.Workflow>}
+ * repeated ValidationIssue issues = 2;
+ * @return {!Array<!proto.proto.ValidationIssue>}
  */
-proto.proto.WorkflowList.prototype.getWorkflowsList = function() {
-  return /** @type{!Array<!proto.proto.Workflow>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.proto.Workflow, 2));
+proto.proto.ValidationResult.prototype.getIssuesList = function() {
+  return /** @type{!Array<!proto.proto.ValidationIssue>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.proto.ValidationIssue, 2));
 };
 
 
 /**
- * @param {!Array<!proto.proto.Workflow>} value
- * @return {!proto.proto.WorkflowList} returns this
+ * @param {!Array<!proto.proto.ValidationIssue>} value
+ * @return {!proto.proto.ValidationResult} returns this
 */
-proto.proto.WorkflowList.prototype.setWorkflowsList = function(value) {
+proto.proto.ValidationResult.prototype.setIssuesList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.proto.Workflow=} opt_value
+ * @param {!proto.proto.ValidationIssue=} opt_value
  * @param {number=} opt_index
- * @return {!proto.proto.Workflow}
+ * @return {!proto.proto.ValidationIssue}
  */
-proto.proto.WorkflowList.prototype.addWorkflows = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.proto.Workflow, opt_index);
+proto.proto.ValidationResult.prototype.addIssues = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.proto.ValidationIssue, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.proto.WorkflowList} returns this
+ * @return {!proto.proto.ValidationResult} returns this
  */
-proto.proto.WorkflowList.prototype.clearWorkflowsList = function() {
-  return this.setWorkflowsList([]);
+proto.proto.ValidationResult.prototype.clearIssuesList = function() {
+  return this.setIssuesList([]);
 };
 
 
@@ -2055,7 +2197,7 @@ proto.proto.WorkflowList.prototype.clearWorkflowsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.proto.Workflow.repeatedFields_ = [4,5,11];
+proto.proto.WorkflowHistoryList.repeatedFields_ = [1];
 
 
 
@@ -2072,8 +2214,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.Workflow.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.Workflow.toObject(opt_includeInstance, this);
+proto.proto.WorkflowHistoryList.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.WorkflowHistoryList.toObject(opt_includeInstance, this);
 };
 
 
@@ -2082,26 +2224,14 @@ proto.proto.Workflow.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.Workflow} msg The msg instance to transform.
+ * @param {!proto.proto.WorkflowHistoryList} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Workflow.toObject = function(includeInstance, msg) {
+proto.proto.WorkflowHistoryList.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-description: jspb.Message.getFieldWithDefault(msg, 3, ""),
-nodesList: jspb.Message.toObjectList(msg.getNodesList(),
-    proto.proto.Node.toObject, includeInstance),
-edgesList: jspb.Message.toObjectList(msg.getEdgesList(),
-    proto.proto.Edge.toObject, includeInstance),
-type: jspb.Message.getFieldWithDefault(msg, 6, ""),
-createdat: jspb.Message.getFieldWithDefault(msg, 7, ""),
-updatedat: jspb.Message.getFieldWithDefault(msg, 8, ""),
-createdby: jspb.Message.getFieldWithDefault(msg, 9, ""),
-updatedby: jspb.Message.getFieldWithDefault(msg, 10, ""),
-tagsList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
-isnew: (f = jspb.Message.getBooleanField(msg, 12)) == null ? undefined : f
+historyList: jspb.Message.toObjectList(msg.getHistoryList(),
+    proto.proto.WorkflowHistory.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2115,23 +2245,23 @@ isnew: (f = jspb.Message.getBooleanField(msg, 12)) == null ? undefined : f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.Workflow}
+ * @return {!proto.proto.WorkflowHistoryList}
  */
-proto.proto.Workflow.deserializeBinary = function(bytes) {
+proto.proto.WorkflowHistoryList.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.Workflow;
-  return proto.proto.Workflow.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.WorkflowHistoryList;
+  return proto.proto.WorkflowHistoryList.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.Workflow} msg The message object to deserialize into.
+ * @param {!proto.proto.WorkflowHistoryList} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.Workflow}
+ * @return {!proto.proto.WorkflowHistoryList}
  */
-proto.proto.Workflow.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.WorkflowHistoryList.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2139,57 +2269,12 @@ proto.proto.Workflow.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      var value = new proto.proto.WorkflowHistory;
+      reader.readMessage(value,proto.proto.WorkflowHistory.deserializeBinaryFromReader);
+      msg.addHistory(value);
       break;
-    case 3:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setDescription(value);
-      break;
-    case 4:
-      var value = new proto.proto.Node;
-      reader.readMessage(value,proto.proto.Node.deserializeBinaryFromReader);
-      msg.addNodes(value);
-      break;
-    case 5:
-      var value = new proto.proto.Edge;
-      reader.readMessage(value,proto.proto.Edge.deserializeBinaryFromReader);
-      msg.addEdges(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setType(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setCreatedat(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUpdatedat(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setCreatedby(value);
-      break;
-    case 10:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUpdatedby(value);
-      break;
-    case 11:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addTags(value);
-      break;
-    case 12:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsnew(value);
-      break;
-    default:
-      reader.skipField();
+    default:
+      reader.skipField();
       break;
     }
   }
@@ -2201,9 +2286,9 @@ proto.proto.Workflow.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.Workflow.prototype.serializeBinary = function() {
+proto.proto.WorkflowHistoryList.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.Workflow.serializeBinaryToWriter(this, writer);
+  proto.proto.WorkflowHistoryList.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2211,391 +2296,308 @@ proto.proto.Workflow.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.Workflow} message
+ * @param {!proto.proto.WorkflowHistoryList} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Workflow.serializeBinaryToWriter = function(message, writer) {
+proto.proto.WorkflowHistoryList.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getNodesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      proto.proto.Node.serializeBinaryToWriter
-    );
-  }
-  f = message.getEdgesList();
+  f = message.getHistoryList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      5,
+      1,
       f,
-      proto.proto.Edge.serializeBinaryToWriter
-    );
-  }
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getCreatedat();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getUpdatedat();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = message.getCreatedby();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = message.getUpdatedby();
-  if (f.length > 0) {
-    writer.writeString(
-      10,
-      f
-    );
-  }
-  f = message.getTagsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      11,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 12));
-  if (f != null) {
-    writer.writeBool(
-      12,
-      f
+      proto.proto.WorkflowHistory.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * repeated WorkflowHistory history = 1;
+ * @return {!Array<!proto.proto.WorkflowHistory>}
  */
-proto.proto.Workflow.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.proto.WorkflowHistoryList.prototype.getHistoryList = function() {
+  return /** @type{!Array<!proto.proto.WorkflowHistory>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.proto.WorkflowHistory, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Workflow} returns this
- */
-proto.proto.Workflow.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {!Array<!proto.proto.WorkflowHistory>} value
+ * @return {!proto.proto.WorkflowHistoryList} returns this
+*/
+proto.proto.WorkflowHistoryList.prototype.setHistoryList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * @param {!proto.proto.WorkflowHistory=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.proto.WorkflowHistory}
  */
-proto.proto.Workflow.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.proto.WorkflowHistoryList.prototype.addHistory = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.proto.WorkflowHistory, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Workflow} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.WorkflowHistoryList} returns this
  */
-proto.proto.Workflow.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.proto.WorkflowHistoryList.prototype.clearHistoryList = function() {
+  return this.setHistoryList([]);
 };
 
 
-/**
- * optional string description = 3;
- * @return {string}
- */
-proto.proto.Workflow.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
 
-/**
- * @param {string} value
- * @return {!proto.proto.Workflow} returns this
- */
-proto.proto.Workflow.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * repeated Node nodes = 4;
- * @return {!Array<!proto.proto.Node>}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.proto.Workflow.prototype.getNodesList = function() {
-  return /** @type{!Array<!proto.proto.Node>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.proto.Node, 4));
-};
-
-
-/**
- * @param {!Array<!proto.proto.Node>} value
- * @return {!proto.proto.Workflow} returns this
-*/
-proto.proto.Workflow.prototype.setNodesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+proto.proto.WorkflowHistory.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.WorkflowHistory.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.proto.Node=} opt_value
- * @param {number=} opt_index
- * @return {!proto.proto.Node}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.WorkflowHistory} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Workflow.prototype.addNodes = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.proto.Node, opt_index);
-};
-
+proto.proto.WorkflowHistory.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+workflowid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+workflowname: jspb.Message.getFieldWithDefault(msg, 3, ""),
+rundate: jspb.Message.getFieldWithDefault(msg, 4, ""),
+status: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
 
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.proto.Workflow} returns this
- */
-proto.proto.Workflow.prototype.clearNodesList = function() {
-  return this.setNodesList([]);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated Edge edges = 5;
- * @return {!Array<!proto.proto.Edge>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.WorkflowHistory}
  */
-proto.proto.Workflow.prototype.getEdgesList = function() {
-  return /** @type{!Array<!proto.proto.Edge>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.proto.Edge, 5));
+proto.proto.WorkflowHistory.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.WorkflowHistory;
+  return proto.proto.WorkflowHistory.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.proto.Edge>} value
- * @return {!proto.proto.Workflow} returns this
-*/
-proto.proto.Workflow.prototype.setEdgesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
-};
-
-
-/**
- * @param {!proto.proto.Edge=} opt_value
- * @param {number=} opt_index
- * @return {!proto.proto.Edge}
- */
-proto.proto.Workflow.prototype.addEdges = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.proto.Edge, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.proto.Workflow} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.WorkflowHistory} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.WorkflowHistory}
  */
-proto.proto.Workflow.prototype.clearEdgesList = function() {
-  return this.setEdgesList([]);
+proto.proto.WorkflowHistory.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setWorkflowid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setWorkflowname(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setRundate(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.proto.NodeStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string type = 6;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.proto.Workflow.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.proto.WorkflowHistory.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.WorkflowHistory.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Workflow} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.WorkflowHistory} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Workflow.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.proto.WorkflowHistory.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getWorkflowid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getWorkflowname();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRundate();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string createdAt = 7;
+ * optional string Id = 1;
  * @return {string}
  */
-proto.proto.Workflow.prototype.getCreatedat = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.proto.WorkflowHistory.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.Workflow} returns this
+ * @return {!proto.proto.WorkflowHistory} returns this
  */
-proto.proto.Workflow.prototype.setCreatedat = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.proto.WorkflowHistory.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string updatedAt = 8;
+ * optional string WorkflowId = 2;
  * @return {string}
  */
-proto.proto.Workflow.prototype.getUpdatedat = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.proto.WorkflowHistory.prototype.getWorkflowid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.Workflow} returns this
+ * @return {!proto.proto.WorkflowHistory} returns this
  */
-proto.proto.Workflow.prototype.setUpdatedat = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.proto.WorkflowHistory.prototype.setWorkflowid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string createdBy = 9;
+ * optional string WorkflowName = 3;
  * @return {string}
  */
-proto.proto.Workflow.prototype.getCreatedby = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.proto.WorkflowHistory.prototype.getWorkflowname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.Workflow} returns this
+ * @return {!proto.proto.WorkflowHistory} returns this
  */
-proto.proto.Workflow.prototype.setCreatedby = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.proto.WorkflowHistory.prototype.setWorkflowname = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string updatedBy = 10;
+ * optional string RunDate = 4;
  * @return {string}
  */
-proto.proto.Workflow.prototype.getUpdatedby = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.proto.Workflow} returns this
- */
-proto.proto.Workflow.prototype.setUpdatedby = function(value) {
-  return jspb.Message.setProto3StringField(this, 10, value);
-};
-
-
-/**
- * repeated string tags = 11;
- * @return {!Array<string>}
- */
-proto.proto.Workflow.prototype.getTagsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 11));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.proto.Workflow} returns this
- */
-proto.proto.Workflow.prototype.setTagsList = function(value) {
-  return jspb.Message.setField(this, 11, value || []);
+proto.proto.WorkflowHistory.prototype.getRundate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.proto.Workflow} returns this
- */
-proto.proto.Workflow.prototype.addTags = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.proto.Workflow} returns this
- */
-proto.proto.Workflow.prototype.clearTagsList = function() {
-  return this.setTagsList([]);
-};
-
-
-/**
- * optional bool isNew = 12;
- * @return {boolean}
- */
-proto.proto.Workflow.prototype.getIsnew = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.proto.Workflow} returns this
+ * @return {!proto.proto.WorkflowHistory} returns this
  */
-proto.proto.Workflow.prototype.setIsnew = function(value) {
-  return jspb.Message.setField(this, 12, value);
+proto.proto.WorkflowHistory.prototype.setRundate = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.proto.Workflow} returns this
+ * optional NodeStatus Status = 5;
+ * @return {!proto.proto.NodeStatus}
  */
-proto.proto.Workflow.prototype.clearIsnew = function() {
-  return jspb.Message.setField(this, 12, undefined);
+proto.proto.WorkflowHistory.prototype.getStatus = function() {
+  return /** @type {!proto.proto.NodeStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.proto.NodeStatus} value
+ * @return {!proto.proto.WorkflowHistory} returns this
  */
-proto.proto.Workflow.prototype.hasIsnew = function() {
-  return jspb.Message.getField(this, 12) != null;
+proto.proto.WorkflowHistory.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
@@ -2615,8 +2617,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.Edge.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.Edge.toObject(opt_includeInstance, this);
+proto.proto.WorkflowHistoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.WorkflowHistoryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2625,27 +2627,13 @@ proto.proto.Edge.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.Edge} msg The msg instance to transform.
+ * @param {!proto.proto.WorkflowHistoryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Edge.toObject = function(includeInstance, msg) {
+proto.proto.WorkflowHistoryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-type: jspb.Message.getFieldWithDefault(msg, 2, ""),
-label: jspb.Message.getFieldWithDefault(msg, 3, ""),
-tabid: jspb.Message.getFieldWithDefault(msg, 4, ""),
-source: jspb.Message.getFieldWithDefault(msg, 5, ""),
-target: jspb.Message.getFieldWithDefault(msg, 6, ""),
-sourcex: jspb.Message.getFloatingPointFieldWithDefault(msg, 7, 0.0),
-sourcey: jspb.Message.getFloatingPointFieldWithDefault(msg, 8, 0.0),
-targetx: jspb.Message.getFloatingPointFieldWithDefault(msg, 9, 0.0),
-targety: jspb.Message.getFloatingPointFieldWithDefault(msg, 10, 0.0),
-animated: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
-sourcenode: (f = msg.getSourcenode()) && proto.proto.Node.toObject(includeInstance, f),
-targetnode: (f = msg.getTargetnode()) && proto.proto.Node.toObject(includeInstance, f),
-sourcehandle: jspb.Message.getFieldWithDefault(msg, 14, ""),
-targethandle: jspb.Message.getFieldWithDefault(msg, 15, "")
+id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2659,23 +2647,23 @@ targethandle: jspb.Message.getFieldWithDefault(msg, 15, "")
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.Edge}
+ * @return {!proto.proto.WorkflowHistoryRequest}
  */
-proto.proto.Edge.deserializeBinary = function(bytes) {
+proto.proto.WorkflowHistoryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.Edge;
-  return proto.proto.Edge.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.WorkflowHistoryRequest;
+  return proto.proto.WorkflowHistoryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.Edge} msg The message object to deserialize into.
+ * @param {!proto.proto.WorkflowHistoryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.Edge}
+ * @return {!proto.proto.WorkflowHistoryRequest}
  */
-proto.proto.Edge.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.WorkflowHistoryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2686,64 +2674,6 @@ proto.proto.Edge.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
       msg.setId(value);
       break;
-    case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setType(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setLabel(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTabid(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setSource(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTarget(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setSourcex(value);
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setSourcey(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setTargetx(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setTargety(value);
-      break;
-    case 11:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAnimated(value);
-      break;
-    case 12:
-      var value = new proto.proto.Node;
-      reader.readMessage(value,proto.proto.Node.deserializeBinaryFromReader);
-      msg.setSourcenode(value);
-      break;
-    case 13:
-      var value = new proto.proto.Node;
-      reader.readMessage(value,proto.proto.Node.deserializeBinaryFromReader);
-      msg.setTargetnode(value);
-      break;
-    case 14:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setSourcehandle(value);
-      break;
-    case 15:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTargethandle(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -2757,9 +2687,9 @@ proto.proto.Edge.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.Edge.prototype.serializeBinary = function() {
+proto.proto.WorkflowHistoryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.Edge.serializeBinaryToWriter(this, writer);
+  proto.proto.WorkflowHistoryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2767,11 +2697,11 @@ proto.proto.Edge.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.Edge} message
+ * @param {!proto.proto.WorkflowHistoryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Edge.serializeBinaryToWriter = function(message, writer) {
+proto.proto.WorkflowHistoryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -2780,106 +2710,6 @@ proto.proto.Edge.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getLabel();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getTabid();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getSource();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getTarget();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getSourcex();
-  if (f !== 0.0) {
-    writer.writeFloat(
-      7,
-      f
-    );
-  }
-  f = message.getSourcey();
-  if (f !== 0.0) {
-    writer.writeFloat(
-      8,
-      f
-    );
-  }
-  f = message.getTargetx();
-  if (f !== 0.0) {
-    writer.writeFloat(
-      9,
-      f
-    );
-  }
-  f = message.getTargety();
-  if (f !== 0.0) {
-    writer.writeFloat(
-      10,
-      f
-    );
-  }
-  f = message.getAnimated();
-  if (f) {
-    writer.writeBool(
-      11,
-      f
-    );
-  }
-  f = message.getSourcenode();
-  if (f != null) {
-    writer.writeMessage(
-      12,
-      f,
-      proto.proto.Node.serializeBinaryToWriter
-    );
-  }
-  f = message.getTargetnode();
-  if (f != null) {
-    writer.writeMessage(
-      13,
-      f,
-      proto.proto.Node.serializeBinaryToWriter
-    );
-  }
-  f = message.getSourcehandle();
-  if (f.length > 0) {
-    writer.writeString(
-      14,
-      f
-    );
-  }
-  f = message.getTargethandle();
-  if (f.length > 0) {
-    writer.writeString(
-      15,
-      f
-    );
-  }
 };
 
 
@@ -2887,262 +2717,415 @@ proto.proto.Edge.serializeBinaryToWriter = function(message, writer) {
  * optional string id = 1;
  * @return {string}
  */
-proto.proto.Edge.prototype.getId = function() {
+proto.proto.WorkflowHistoryRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.Edge} returns this
+ * @return {!proto.proto.WorkflowHistoryRequest} returns this
  */
-proto.proto.Edge.prototype.setId = function(value) {
+proto.proto.WorkflowHistoryRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string type = 2;
- * @return {string}
- */
-proto.proto.Edge.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.proto.Edge} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.proto.Edge.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
+proto.proto.WorkflowHistoryResponse.repeatedFields_ = [1];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string label = 3;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.proto.Edge.prototype.getLabel = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.proto.WorkflowHistoryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.WorkflowHistoryResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Edge} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.WorkflowHistoryResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Edge.prototype.setLabel = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.proto.WorkflowHistoryResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+dataList: jspb.Message.toObjectList(msg.getDataList(),
+    proto.proto.ReplayData.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string tabid = 4;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.WorkflowHistoryResponse}
  */
-proto.proto.Edge.prototype.getTabid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.proto.WorkflowHistoryResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.WorkflowHistoryResponse;
+  return proto.proto.WorkflowHistoryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Edge} returns this
- */
-proto.proto.Edge.prototype.setTabid = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional string source = 5;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.WorkflowHistoryResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.WorkflowHistoryResponse}
  */
-proto.proto.Edge.prototype.getSource = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.proto.WorkflowHistoryResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.proto.ReplayData;
+      reader.readMessage(value,proto.proto.ReplayData.deserializeBinaryFromReader);
+      msg.addData(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Edge} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.proto.Edge.prototype.setSource = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.proto.WorkflowHistoryResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.WorkflowHistoryResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional string target = 6;
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.WorkflowHistoryResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Edge.prototype.getTarget = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.proto.WorkflowHistoryResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDataList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.proto.ReplayData.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Edge} returns this
+ * repeated ReplayData data = 1;
+ * @return {!Array<!proto.proto.ReplayData>}
  */
-proto.proto.Edge.prototype.setTarget = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.proto.WorkflowHistoryResponse.prototype.getDataList = function() {
+  return /** @type{!Array<!proto.proto.ReplayData>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.proto.ReplayData, 1));
 };
 
 
 /**
- * optional float sourcex = 7;
- * @return {number}
- */
-proto.proto.Edge.prototype.getSourcex = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 7, 0.0));
+ * @param {!Array<!proto.proto.ReplayData>} value
+ * @return {!proto.proto.WorkflowHistoryResponse} returns this
+*/
+proto.proto.WorkflowHistoryResponse.prototype.setDataList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.proto.Edge} returns this
+ * @param {!proto.proto.ReplayData=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.proto.ReplayData}
  */
-proto.proto.Edge.prototype.setSourcex = function(value) {
-  return jspb.Message.setProto3FloatField(this, 7, value);
+proto.proto.WorkflowHistoryResponse.prototype.addData = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.proto.ReplayData, opt_index);
 };
 
 
 /**
- * optional float sourcey = 8;
- * @return {number}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.WorkflowHistoryResponse} returns this
  */
-proto.proto.Edge.prototype.getSourcey = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 8, 0.0));
+proto.proto.WorkflowHistoryResponse.prototype.clearDataList = function() {
+  return this.setDataList([]);
 };
 
 
-/**
- * @param {number} value
- * @return {!proto.proto.Edge} returns this
- */
-proto.proto.Edge.prototype.setSourcey = function(value) {
-  return jspb.Message.setProto3FloatField(this, 8, value);
-};
-
 
-/**
- * optional float targetx = 9;
- * @return {number}
- */
-proto.proto.Edge.prototype.getTargetx = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 9, 0.0));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {number} value
- * @return {!proto.proto.Edge} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.proto.Edge.prototype.setTargetx = function(value) {
-  return jspb.Message.setProto3FloatField(this, 9, value);
+proto.proto.ReplayData.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.ReplayData.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional float targety = 10;
- * @return {number}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.ReplayData} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Edge.prototype.getTargety = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 10, 0.0));
-};
-
+proto.proto.ReplayData.toObject = function(includeInstance, msg) {
+  var f, obj = {
+nodeid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+data: (f = msg.getData()) && proto.proto.NodeData.toObject(includeInstance, f),
+variablesMap: (f = msg.getVariablesMap()) ? f.toObject(includeInstance, undefined) : [],
+status: jspb.Message.getFieldWithDefault(msg, 4, 0),
+message: jspb.Message.getFieldWithDefault(msg, 5, ""),
+processid: jspb.Message.getFieldWithDefault(msg, 6, ""),
+typedvariables: (f = msg.getTypedvariables()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
 
-/**
- * @param {number} value
- * @return {!proto.proto.Edge} returns this
- */
-proto.proto.Edge.prototype.setTargety = function(value) {
-  return jspb.Message.setProto3FloatField(this, 10, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional bool animated = 11;
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.ReplayData}
  */
-proto.proto.Edge.prototype.getAnimated = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+proto.proto.ReplayData.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.ReplayData;
+  return proto.proto.ReplayData.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.proto.Edge} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.ReplayData} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.ReplayData}
  */
-proto.proto.Edge.prototype.setAnimated = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 11, value);
+proto.proto.ReplayData.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setNodeid(value);
+      break;
+    case 2:
+      var value = new proto.proto.NodeData;
+      reader.readMessage(value,proto.proto.NodeData.deserializeBinaryFromReader);
+      msg.setData(value);
+      break;
+    case 3:
+      var value = msg.getVariablesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readStringRequireUtf8, jspb.BinaryReader.prototype.readStringRequireUtf8, null, "", "");
+         });
+      break;
+    case 4:
+      var value = /** @type {!proto.proto.NodeStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setProcessid(value);
+      break;
+    case 7:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setTypedvariables(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional Node sourcenode = 12;
- * @return {?proto.proto.Node}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.proto.Edge.prototype.getSourcenode = function() {
-  return /** @type{?proto.proto.Node} */ (
-    jspb.Message.getWrapperField(this, proto.proto.Node, 12));
-};
-
-
-/**
- * @param {?proto.proto.Node|undefined} value
- * @return {!proto.proto.Edge} returns this
-*/
-proto.proto.Edge.prototype.setSourcenode = function(value) {
-  return jspb.Message.setWrapperField(this, 12, value);
+proto.proto.ReplayData.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.ReplayData.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.proto.Edge} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.ReplayData} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Edge.prototype.clearSourcenode = function() {
-  return this.setSourcenode(undefined);
-};
-
-
+proto.proto.ReplayData.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNodeid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getData();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.proto.NodeData.serializeBinaryToWriter
+    );
+  }
+  f = message.getVariablesMap(true);
+  if (f && f.getLength() > 0) {
+jspb.internal.public_for_gencode.serializeMapToBinary(
+    message.getVariablesMap(true),
+    3,
+    writer,
+    jspb.BinaryWriter.prototype.writeString,
+    jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getProcessid();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getTypedvariables();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string nodeId = 1;
+ * @return {string}
  */
-proto.proto.Edge.prototype.hasSourcenode = function() {
-  return jspb.Message.getField(this, 12) != null;
+proto.proto.ReplayData.prototype.getNodeid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional Node targetnode = 13;
- * @return {?proto.proto.Node}
+ * @param {string} value
+ * @return {!proto.proto.ReplayData} returns this
  */
-proto.proto.Edge.prototype.getTargetnode = function() {
-  return /** @type{?proto.proto.Node} */ (
-    jspb.Message.getWrapperField(this, proto.proto.Node, 13));
+proto.proto.ReplayData.prototype.setNodeid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {?proto.proto.Node|undefined} value
- * @return {!proto.proto.Edge} returns this
+ * optional NodeData data = 2;
+ * @return {?proto.proto.NodeData}
+ */
+proto.proto.ReplayData.prototype.getData = function() {
+  return /** @type{?proto.proto.NodeData} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeData, 2));
+};
+
+
+/**
+ * @param {?proto.proto.NodeData|undefined} value
+ * @return {!proto.proto.ReplayData} returns this
 */
-proto.proto.Edge.prototype.setTargetnode = function(value) {
-  return jspb.Message.setWrapperField(this, 13, value);
+proto.proto.ReplayData.prototype.setData = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.proto.Edge} returns this
+ * @return {!proto.proto.ReplayData} returns this
  */
-proto.proto.Edge.prototype.clearTargetnode = function() {
-  return this.setTargetnode(undefined);
+proto.proto.ReplayData.prototype.clearData = function() {
+  return this.setData(undefined);
 };
 
 
@@ -3150,54 +3133,125 @@ proto.proto.Edge.prototype.clearTargetnode = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.Edge.prototype.hasTargetnode = function() {
-  return jspb.Message.getField(this, 13) != null;
+proto.proto.ReplayData.prototype.hasData = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional string sourcehandle = 14;
+ * map<string, string> variables = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.proto.ReplayData.prototype.getVariablesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.proto.ReplayData} returns this
+ */
+proto.proto.ReplayData.prototype.clearVariablesMap = function() {
+  this.getVariablesMap().clear();
+  return this;
+};
+
+
+/**
+ * optional NodeStatus status = 4;
+ * @return {!proto.proto.NodeStatus}
+ */
+proto.proto.ReplayData.prototype.getStatus = function() {
+  return /** @type {!proto.proto.NodeStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.proto.NodeStatus} value
+ * @return {!proto.proto.ReplayData} returns this
+ */
+proto.proto.ReplayData.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional string message = 5;
  * @return {string}
  */
-proto.proto.Edge.prototype.getSourcehandle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+proto.proto.ReplayData.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.Edge} returns this
+ * @return {!proto.proto.ReplayData} returns this
  */
-proto.proto.Edge.prototype.setSourcehandle = function(value) {
-  return jspb.Message.setProto3StringField(this, 14, value);
+proto.proto.ReplayData.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * optional string targethandle = 15;
+ * optional string processId = 6;
  * @return {string}
  */
-proto.proto.Edge.prototype.getTargethandle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+proto.proto.ReplayData.prototype.getProcessid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.Edge} returns this
+ * @return {!proto.proto.ReplayData} returns this
  */
-proto.proto.Edge.prototype.setTargethandle = function(value) {
-  return jspb.Message.setProto3StringField(this, 15, value);
+proto.proto.ReplayData.prototype.setProcessid = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
+/**
+ * optional google.protobuf.Struct typedVariables = 7;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.proto.ReplayData.prototype.getTypedvariables = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 7));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.proto.ReplayData} returns this
+*/
+proto.proto.ReplayData.prototype.setTypedvariables = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.ReplayData} returns this
  */
-proto.proto.Node.repeatedFields_ = [5,7,8];
+proto.proto.ReplayData.prototype.clearTypedvariables = function() {
+  return this.setTypedvariables(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.ReplayData.prototype.hasTypedvariables = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
 
 
 
@@ -3214,8 +3268,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.Node.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.Node.toObject(opt_includeInstance, this);
+proto.proto.PageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.PageRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3224,28 +3278,14 @@ proto.proto.Node.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.Node} msg The msg instance to transform.
+ * @param {!proto.proto.PageRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Node.toObject = function(includeInstance, msg) {
+proto.proto.PageRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-nodetype: jspb.Message.getFieldWithDefault(msg, 2, ""),
-label: jspb.Message.getFieldWithDefault(msg, 3, ""),
-data: (f = msg.getData()) && proto.proto.NodeData.toObject(includeInstance, f),
-groupList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
-nodestyle: (f = jspb.Message.getField(msg, 6)) == null ? undefined : f,
-inputsList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
-outputsList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
-draggable: (f = jspb.Message.getBooleanField(msg, 9)) == null ? undefined : f,
-icon: (f = msg.getIcon()) && proto.proto.NodeIcon.toObject(includeInstance, f),
-position: (f = msg.getPosition()) && proto.proto.NodePosition.toObject(includeInstance, f),
-nodestatus: (f = jspb.Message.getField(msg, 12)) == null ? undefined : f,
-type: (f = jspb.Message.getField(msg, 13)) == null ? undefined : f,
-dimensions: (f = msg.getDimensions()) && proto.proto.NodeDimensions.toObject(includeInstance, f),
-handlebounds: (f = msg.getHandlebounds()) && proto.proto.NodeHandleBounds.toObject(includeInstance, f),
-computedposition: (f = msg.getComputedposition()) && proto.proto.NodePosition.toObject(includeInstance, f)
+limit: jspb.Message.getFieldWithDefault(msg, 1, 0),
+offset: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3259,23 +3299,23 @@ computedposition: (f = msg.getComputedposition()) && proto.proto.NodePosition.to
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.Node}
+ * @return {!proto.proto.PageRequest}
  */
-proto.proto.Node.deserializeBinary = function(bytes) {
+proto.proto.PageRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.Node;
-  return proto.proto.Node.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.PageRequest;
+  return proto.proto.PageRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.Node} msg The message object to deserialize into.
+ * @param {!proto.proto.PageRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.Node}
+ * @return {!proto.proto.PageRequest}
  */
-proto.proto.Node.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.PageRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3283,76 +3323,15 @@ proto.proto.Node.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setNodetype(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setLabel(value);
-      break;
-    case 4:
-      var value = new proto.proto.NodeData;
-      reader.readMessage(value,proto.proto.NodeData.deserializeBinaryFromReader);
-      msg.setData(value);
-      break;
-    case 5:
-      reader.readPackableInt32Into(msg.getGroupList());
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOffset(value);
       break;
-    case 6:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setNodestyle(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addInputs(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.addOutputs(value);
-      break;
-    case 9:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDraggable(value);
-      break;
-    case 10:
-      var value = new proto.proto.NodeIcon;
-      reader.readMessage(value,proto.proto.NodeIcon.deserializeBinaryFromReader);
-      msg.setIcon(value);
-      break;
-    case 11:
-      var value = new proto.proto.NodePosition;
-      reader.readMessage(value,proto.proto.NodePosition.deserializeBinaryFromReader);
-      msg.setPosition(value);
-      break;
-    case 12:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setNodestatus(value);
-      break;
-    case 13:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setType(value);
-      break;
-    case 14:
-      var value = new proto.proto.NodeDimensions;
-      reader.readMessage(value,proto.proto.NodeDimensions.deserializeBinaryFromReader);
-      msg.setDimensions(value);
-      break;
-    case 15:
-      var value = new proto.proto.NodeHandleBounds;
-      reader.readMessage(value,proto.proto.NodeHandleBounds.deserializeBinaryFromReader);
-      msg.setHandlebounds(value);
-      break;
-    case 16:
-      var value = new proto.proto.NodePosition;
-      reader.readMessage(value,proto.proto.NodePosition.deserializeBinaryFromReader);
-      msg.setComputedposition(value);
-      break;
-    default:
-      reader.skipField();
+    default:
+      reader.skipField();
       break;
     }
   }
@@ -3364,9 +3343,9 @@ proto.proto.Node.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.Node.prototype.serializeBinary = function() {
+proto.proto.PageRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.Node.serializeBinaryToWriter(this, writer);
+  proto.proto.PageRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3374,667 +3353,986 @@ proto.proto.Node.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.Node} message
+ * @param {!proto.proto.PageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Node.serializeBinaryToWriter = function(message, writer) {
+proto.proto.PageRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getNodetype();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getLabel();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getData();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.proto.NodeData.serializeBinaryToWriter
-    );
-  }
-  f = message.getGroupList();
-  if (f.length > 0) {
-    writer.writePackedInt32(
-      5,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getInputsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      7,
-      f
-    );
-  }
-  f = message.getOutputsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      8,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 9));
-  if (f != null) {
-    writer.writeBool(
-      9,
-      f
-    );
-  }
-  f = message.getIcon();
-  if (f != null) {
-    writer.writeMessage(
-      10,
-      f,
-      proto.proto.NodeIcon.serializeBinaryToWriter
-    );
-  }
-  f = message.getPosition();
-  if (f != null) {
-    writer.writeMessage(
-      11,
-      f,
-      proto.proto.NodePosition.serializeBinaryToWriter
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 12));
-  if (f != null) {
-    writer.writeString(
-      12,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 13));
-  if (f != null) {
-    writer.writeString(
-      13,
-      f
-    );
-  }
-  f = message.getDimensions();
-  if (f != null) {
-    writer.writeMessage(
-      14,
-      f,
-      proto.proto.NodeDimensions.serializeBinaryToWriter
-    );
-  }
-  f = message.getHandlebounds();
-  if (f != null) {
-    writer.writeMessage(
-      15,
-      f,
-      proto.proto.NodeHandleBounds.serializeBinaryToWriter
-    );
-  }
-  f = message.getComputedposition();
-  if (f != null) {
-    writer.writeMessage(
-      16,
-      f,
-      proto.proto.NodePosition.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional int32 limit = 1;
+ * @return {number}
  */
-proto.proto.Node.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.proto.PageRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Node} returns this
+ * @param {number} value
+ * @return {!proto.proto.PageRequest} returns this
  */
-proto.proto.Node.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.proto.PageRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string nodetype = 2;
- * @return {string}
+ * optional int32 offset = 2;
+ * @return {number}
  */
-proto.proto.Node.prototype.getNodetype = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.proto.PageRequest.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Node} returns this
+ * @param {number} value
+ * @return {!proto.proto.PageRequest} returns this
  */
-proto.proto.Node.prototype.setNodetype = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.proto.PageRequest.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string label = 3;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.proto.Node.prototype.getLabel = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.proto.GetWorkflowRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.GetWorkflowRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Node} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.GetWorkflowRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Node.prototype.setLabel = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.proto.GetWorkflowRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional NodeData data = 4;
- * @return {?proto.proto.NodeData}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.GetWorkflowRequest}
  */
-proto.proto.Node.prototype.getData = function() {
-  return /** @type{?proto.proto.NodeData} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodeData, 4));
+proto.proto.GetWorkflowRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.GetWorkflowRequest;
+  return proto.proto.GetWorkflowRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.proto.NodeData|undefined} value
- * @return {!proto.proto.Node} returns this
-*/
-proto.proto.Node.prototype.setData = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.GetWorkflowRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.GetWorkflowRequest}
+ */
+proto.proto.GetWorkflowRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.proto.Node.prototype.clearData = function() {
-  return this.setData(undefined);
+proto.proto.GetWorkflowRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.GetWorkflowRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.GetWorkflowRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Node.prototype.hasData = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.proto.GetWorkflowRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated int32 group = 5;
- * @return {!Array<number>}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.proto.Node.prototype.getGroupList = function() {
-  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 5));
+proto.proto.GetWorkflowRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<number>} value
- * @return {!proto.proto.Node} returns this
+ * @param {string} value
+ * @return {!proto.proto.GetWorkflowRequest} returns this
  */
-proto.proto.Node.prototype.setGroupList = function(value) {
-  return jspb.Message.setField(this, 5, value || []);
+proto.proto.GetWorkflowRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
 /**
- * @param {number} value
- * @param {number=} opt_index
- * @return {!proto.proto.Node} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.proto.Node.prototype.addGroup = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
-};
+proto.proto.WorkflowList.repeatedFields_ = [2];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.proto.Node} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.proto.Node.prototype.clearGroupList = function() {
-  return this.setGroupList([]);
+proto.proto.WorkflowList.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.WorkflowList.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional string nodestyle = 6;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.WorkflowList} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Node.prototype.getNodestyle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.proto.WorkflowList.toObject = function(includeInstance, msg) {
+  var f, obj = {
+total: jspb.Message.getFieldWithDefault(msg, 1, 0),
+workflowsList: jspb.Message.toObjectList(msg.getWorkflowsList(),
+    proto.proto.Workflow.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Node} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.WorkflowList}
  */
-proto.proto.Node.prototype.setNodestyle = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.proto.WorkflowList.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.WorkflowList;
+  return proto.proto.WorkflowList.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.WorkflowList} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.WorkflowList}
  */
-proto.proto.Node.prototype.clearNodestyle = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.proto.WorkflowList.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTotal(value);
+      break;
+    case 2:
+      var value = new proto.proto.Workflow;
+      reader.readMessage(value,proto.proto.Workflow.deserializeBinaryFromReader);
+      msg.addWorkflows(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.proto.Node.prototype.hasNodestyle = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.proto.WorkflowList.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.WorkflowList.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * repeated string inputs = 7;
- * @return {!Array<string>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.WorkflowList} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Node.prototype.getInputsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 7));
+proto.proto.WorkflowList.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getWorkflowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.proto.Workflow.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.proto.Node} returns this
+ * optional int32 total = 1;
+ * @return {number}
  */
-proto.proto.Node.prototype.setInputsList = function(value) {
-  return jspb.Message.setField(this, 7, value || []);
+proto.proto.WorkflowList.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.proto.Node} returns this
+ * @param {number} value
+ * @return {!proto.proto.WorkflowList} returns this
  */
-proto.proto.Node.prototype.addInputs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+proto.proto.WorkflowList.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.proto.Node} returns this
+ * repeated Workflow workflows = 2;
+ * @return {!Array<!proto.proto.Workflow>}
  */
-proto.proto.Node.prototype.clearInputsList = function() {
-  return this.setInputsList([]);
+proto.proto.WorkflowList.prototype.getWorkflowsList = function() {
+  return /** @type{!Array<!proto.proto.Workflow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.proto.Workflow, 2));
 };
 
 
 /**
- * repeated string outputs = 8;
- * @return {!Array<string>}
- */
-proto.proto.Node.prototype.getOutputsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+ * @param {!Array<!proto.proto.Workflow>} value
+ * @return {!proto.proto.WorkflowList} returns this
+*/
+proto.proto.WorkflowList.prototype.setWorkflowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.proto.Node} returns this
+ * @param {!proto.proto.Workflow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.proto.Workflow}
  */
-proto.proto.Node.prototype.setOutputsList = function(value) {
-  return jspb.Message.setField(this, 8, value || []);
+proto.proto.WorkflowList.prototype.addWorkflows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.proto.Workflow, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.proto.Node} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.WorkflowList} returns this
  */
-proto.proto.Node.prototype.addOutputs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+proto.proto.WorkflowList.prototype.clearWorkflowsList = function() {
+  return this.setWorkflowsList([]);
 };
 
 
+
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.proto.Node} returns this
- */
-proto.proto.Node.prototype.clearOutputsList = function() {
-  return this.setOutputsList([]);
-};
-
-
-/**
- * optional bool draggable = 9;
- * @return {boolean}
- */
-proto.proto.Node.prototype.getDraggable = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.proto.Node} returns this
- */
-proto.proto.Node.prototype.setDraggable = function(value) {
-  return jspb.Message.setField(this, 9, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.proto.Node} returns this
- */
-proto.proto.Node.prototype.clearDraggable = function() {
-  return jspb.Message.setField(this, 9, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.proto.Node.prototype.hasDraggable = function() {
-  return jspb.Message.getField(this, 9) != null;
-};
-
-
-/**
- * optional NodeIcon icon = 10;
- * @return {?proto.proto.NodeIcon}
- */
-proto.proto.Node.prototype.getIcon = function() {
-  return /** @type{?proto.proto.NodeIcon} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodeIcon, 10));
-};
-
-
-/**
- * @param {?proto.proto.NodeIcon|undefined} value
- * @return {!proto.proto.Node} returns this
-*/
-proto.proto.Node.prototype.setIcon = function(value) {
-  return jspb.Message.setWrapperField(this, 10, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.proto.Node.prototype.clearIcon = function() {
-  return this.setIcon(undefined);
-};
-
+proto.proto.Workflow.repeatedFields_ = [4,5,11];
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.proto.Node.prototype.hasIcon = function() {
-  return jspb.Message.getField(this, 10) != null;
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional NodePosition position = 11;
- * @return {?proto.proto.NodePosition}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.proto.Node.prototype.getPosition = function() {
-  return /** @type{?proto.proto.NodePosition} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodePosition, 11));
-};
-
-
-/**
- * @param {?proto.proto.NodePosition|undefined} value
- * @return {!proto.proto.Node} returns this
-*/
-proto.proto.Node.prototype.setPosition = function(value) {
-  return jspb.Message.setWrapperField(this, 11, value);
+proto.proto.Workflow.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.Workflow.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.Workflow} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Node.prototype.clearPosition = function() {
-  return this.setPosition(undefined);
-};
-
+proto.proto.Workflow.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+description: jspb.Message.getFieldWithDefault(msg, 3, ""),
+nodesList: jspb.Message.toObjectList(msg.getNodesList(),
+    proto.proto.Node.toObject, includeInstance),
+edgesList: jspb.Message.toObjectList(msg.getEdgesList(),
+    proto.proto.Edge.toObject, includeInstance),
+type: jspb.Message.getFieldWithDefault(msg, 6, ""),
+createdat: jspb.Message.getFieldWithDefault(msg, 7, ""),
+updatedat: jspb.Message.getFieldWithDefault(msg, 8, ""),
+createdby: jspb.Message.getFieldWithDefault(msg, 9, ""),
+updatedby: jspb.Message.getFieldWithDefault(msg, 10, ""),
+tagsList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
+isnew: (f = jspb.Message.getBooleanField(msg, 12)) == null ? undefined : f,
+timeoutms: (f = jspb.Message.getField(msg, 13)) == null ? undefined : f
+  };
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.proto.Node.prototype.hasPosition = function() {
-  return jspb.Message.getField(this, 11) != null;
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string nodestatus = 12;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.Workflow}
  */
-proto.proto.Node.prototype.getNodestatus = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.proto.Workflow.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.Workflow;
+  return proto.proto.Workflow.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.Node} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.Workflow} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.Workflow}
  */
-proto.proto.Node.prototype.setNodestatus = function(value) {
-  return jspb.Message.setField(this, 12, value);
+proto.proto.Workflow.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setDescription(value);
+      break;
+    case 4:
+      var value = new proto.proto.Node;
+      reader.readMessage(value,proto.proto.Node.deserializeBinaryFromReader);
+      msg.addNodes(value);
+      break;
+    case 5:
+      var value = new proto.proto.Edge;
+      reader.readMessage(value,proto.proto.Edge.deserializeBinaryFromReader);
+      msg.addEdges(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setType(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setCreatedat(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUpdatedat(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setCreatedby(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUpdatedby(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addTags(value);
+      break;
+    case 12:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsnew(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTimeoutms(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.proto.Node.prototype.clearNodestatus = function() {
-  return jspb.Message.setField(this, 12, undefined);
+proto.proto.Workflow.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.Workflow.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.Workflow} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.Node.prototype.hasNodestatus = function() {
-  return jspb.Message.getField(this, 12) != null;
-};
-
-
+proto.proto.Workflow.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getNodesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.proto.Node.serializeBinaryToWriter
+    );
+  }
+  f = message.getEdgesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.proto.Edge.serializeBinaryToWriter
+    );
+  }
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getCreatedat();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getUpdatedat();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getCreatedby();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getUpdatedby();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getTagsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      11,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 12));
+  if (f != null) {
+    writer.writeBool(
+      12,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 13));
+  if (f != null) {
+    writer.writeInt32(
+      13,
+      f
+    );
+  }
+};
+
+
 /**
- * optional string type = 13;
+ * optional string id = 1;
  * @return {string}
  */
-proto.proto.Node.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+proto.proto.Workflow.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.proto.Node} returns this
+ * @return {!proto.proto.Workflow} returns this
  */
-proto.proto.Node.prototype.setType = function(value) {
-  return jspb.Message.setField(this, 13, value);
+proto.proto.Workflow.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * optional string name = 2;
+ * @return {string}
  */
-proto.proto.Node.prototype.clearType = function() {
-  return jspb.Message.setField(this, 13, undefined);
+proto.proto.Workflow.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.proto.Workflow} returns this
  */
-proto.proto.Node.prototype.hasType = function() {
-  return jspb.Message.getField(this, 13) != null;
+proto.proto.Workflow.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional NodeDimensions dimensions = 14;
- * @return {?proto.proto.NodeDimensions}
+ * optional string description = 3;
+ * @return {string}
  */
-proto.proto.Node.prototype.getDimensions = function() {
-  return /** @type{?proto.proto.NodeDimensions} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodeDimensions, 14));
+proto.proto.Workflow.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {?proto.proto.NodeDimensions|undefined} value
- * @return {!proto.proto.Node} returns this
+ * @param {string} value
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated Node nodes = 4;
+ * @return {!Array<!proto.proto.Node>}
+ */
+proto.proto.Workflow.prototype.getNodesList = function() {
+  return /** @type{!Array<!proto.proto.Node>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.proto.Node, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.proto.Node>} value
+ * @return {!proto.proto.Workflow} returns this
 */
-proto.proto.Node.prototype.setDimensions = function(value) {
-  return jspb.Message.setWrapperField(this, 14, value);
+proto.proto.Workflow.prototype.setNodesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * @param {!proto.proto.Node=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.proto.Node}
  */
-proto.proto.Node.prototype.clearDimensions = function() {
-  return this.setDimensions(undefined);
+proto.proto.Workflow.prototype.addNodes = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.proto.Node, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.Workflow} returns this
  */
-proto.proto.Node.prototype.hasDimensions = function() {
-  return jspb.Message.getField(this, 14) != null;
+proto.proto.Workflow.prototype.clearNodesList = function() {
+  return this.setNodesList([]);
 };
 
 
 /**
- * optional NodeHandleBounds handleBounds = 15;
- * @return {?proto.proto.NodeHandleBounds}
+ * repeated Edge edges = 5;
+ * @return {!Array<!proto.proto.Edge>}
  */
-proto.proto.Node.prototype.getHandlebounds = function() {
-  return /** @type{?proto.proto.NodeHandleBounds} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodeHandleBounds, 15));
+proto.proto.Workflow.prototype.getEdgesList = function() {
+  return /** @type{!Array<!proto.proto.Edge>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.proto.Edge, 5));
 };
 
 
 /**
- * @param {?proto.proto.NodeHandleBounds|undefined} value
- * @return {!proto.proto.Node} returns this
+ * @param {!Array<!proto.proto.Edge>} value
+ * @return {!proto.proto.Workflow} returns this
 */
-proto.proto.Node.prototype.setHandlebounds = function(value) {
-  return jspb.Message.setWrapperField(this, 15, value);
+proto.proto.Workflow.prototype.setEdgesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * @param {!proto.proto.Edge=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.proto.Edge}
  */
-proto.proto.Node.prototype.clearHandlebounds = function() {
-  return this.setHandlebounds(undefined);
+proto.proto.Workflow.prototype.addEdges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.proto.Edge, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.Workflow} returns this
  */
-proto.proto.Node.prototype.hasHandlebounds = function() {
-  return jspb.Message.getField(this, 15) != null;
+proto.proto.Workflow.prototype.clearEdgesList = function() {
+  return this.setEdgesList([]);
 };
 
 
 /**
- * optional NodePosition computedPosition = 16;
- * @return {?proto.proto.NodePosition}
+ * optional string type = 6;
+ * @return {string}
  */
-proto.proto.Node.prototype.getComputedposition = function() {
-  return /** @type{?proto.proto.NodePosition} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodePosition, 16));
+proto.proto.Workflow.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * @param {?proto.proto.NodePosition|undefined} value
- * @return {!proto.proto.Node} returns this
-*/
-proto.proto.Node.prototype.setComputedposition = function(value) {
-  return jspb.Message.setWrapperField(this, 16, value);
+ * @param {string} value
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.proto.Node} returns this
+ * optional string createdAt = 7;
+ * @return {string}
  */
-proto.proto.Node.prototype.clearComputedposition = function() {
-  return this.setComputedposition(undefined);
+proto.proto.Workflow.prototype.getCreatedat = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.proto.Workflow} returns this
  */
-proto.proto.Node.prototype.hasComputedposition = function() {
-  return jspb.Message.getField(this, 16) != null;
+proto.proto.Workflow.prototype.setCreatedat = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
+/**
+ * optional string updatedAt = 8;
+ * @return {string}
+ */
+proto.proto.Workflow.prototype.getUpdatedat = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.setUpdatedat = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional string createdBy = 9;
+ * @return {string}
+ */
+proto.proto.Workflow.prototype.getCreatedby = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.setCreatedby = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional string updatedBy = 10;
+ * @return {string}
+ */
+proto.proto.Workflow.prototype.getUpdatedby = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.setUpdatedby = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * repeated string tags = 11;
+ * @return {!Array<string>}
+ */
+proto.proto.Workflow.prototype.getTagsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 11));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.setTagsList = function(value) {
+  return jspb.Message.setField(this, 11, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.addTags = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.clearTagsList = function() {
+  return this.setTagsList([]);
+};
+
+
+/**
+ * optional bool isNew = 12;
+ * @return {boolean}
+ */
+proto.proto.Workflow.prototype.getIsnew = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.setIsnew = function(value) {
+  return jspb.Message.setField(this, 12, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.clearIsnew = function() {
+  return jspb.Message.setField(this, 12, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Workflow.prototype.hasIsnew = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional int32 timeoutMs = 13;
+ * @return {number}
+ */
+proto.proto.Workflow.prototype.getTimeoutms = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.setTimeoutms = function(value) {
+  return jspb.Message.setField(this, 13, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.Workflow} returns this
+ */
+proto.proto.Workflow.prototype.clearTimeoutms = function() {
+  return jspb.Message.setField(this, 13, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Workflow.prototype.hasTimeoutms = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -4048,8 +4346,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.proto.NodeData.prototype.toObject = function(opt_includeInstance) {
-  return proto.proto.NodeData.toObject(opt_includeInstance, this);
+proto.proto.Edge.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.Edge.toObject(opt_includeInstance, this);
 };
 
 
@@ -4058,34 +4356,27 @@ proto.proto.NodeData.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.proto.NodeData} msg The msg instance to transform.
+ * @param {!proto.proto.Edge} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.NodeData.toObject = function(includeInstance, msg) {
+proto.proto.Edge.toObject = function(includeInstance, msg) {
   var f, obj = {
-name: (f = jspb.Message.getField(msg, 1)) == null ? undefined : f,
-value: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f,
-expression: (f = jspb.Message.getField(msg, 3)) == null ? undefined : f,
-iteration: (f = jspb.Message.getField(msg, 4)) == null ? undefined : f,
-listvar: (f = jspb.Message.getField(msg, 5)) == null ? undefined : f,
-limit: (f = jspb.Message.getField(msg, 6)) == null ? undefined : f,
-url: (f = jspb.Message.getField(msg, 7)) == null ? undefined : f,
-method: (f = jspb.Message.getField(msg, 8)) == null ? undefined : f,
-headers: (f = msg.getHeaders()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
-payload: (f = jspb.Message.getField(msg, 10)) == null ? undefined : f,
-variable: (f = jspb.Message.getField(msg, 11)) == null ? undefined : f,
-message: (f = jspb.Message.getField(msg, 12)) == null ? undefined : f,
-list: (f = msg.getList()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
-listvariable: (f = jspb.Message.getField(msg, 14)) == null ? undefined : f,
-template: (f = jspb.Message.getField(msg, 15)) == null ? undefined : f,
-text: (f = jspb.Message.getField(msg, 16)) == null ? undefined : f,
-pattern: (f = jspb.Message.getField(msg, 17)) == null ? undefined : f,
-replacetext: (f = jspb.Message.getField(msg, 18)) == null ? undefined : f,
-subprocessid: (f = jspb.Message.getField(msg, 19)) == null ? undefined : f,
-type: (f = jspb.Message.getField(msg, 20)) == null ? undefined : f,
-interval: (f = jspb.Message.getField(msg, 21)) == null ? undefined : f,
-weeks: (f = msg.getWeeks()) && proto.proto.NodeDataArray.toObject(includeInstance, f)
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+type: jspb.Message.getFieldWithDefault(msg, 2, ""),
+label: jspb.Message.getFieldWithDefault(msg, 3, ""),
+tabid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+source: jspb.Message.getFieldWithDefault(msg, 5, ""),
+target: jspb.Message.getFieldWithDefault(msg, 6, ""),
+sourcex: jspb.Message.getFloatingPointFieldWithDefault(msg, 7, 0.0),
+sourcey: jspb.Message.getFloatingPointFieldWithDefault(msg, 8, 0.0),
+targetx: jspb.Message.getFloatingPointFieldWithDefault(msg, 9, 0.0),
+targety: jspb.Message.getFloatingPointFieldWithDefault(msg, 10, 0.0),
+animated: jspb.Message.getBooleanFieldWithDefault(msg, 11, false),
+sourcenode: (f = msg.getSourcenode()) && proto.proto.Node.toObject(includeInstance, f),
+targetnode: (f = msg.getTargetnode()) && proto.proto.Node.toObject(includeInstance, f),
+sourcehandle: jspb.Message.getFieldWithDefault(msg, 14, ""),
+targethandle: jspb.Message.getFieldWithDefault(msg, 15, "")
   };
 
   if (includeInstance) {
@@ -4099,23 +4390,23 @@ weeks: (f = msg.getWeeks()) && proto.proto.NodeDataArray.toObject(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.proto.NodeData}
+ * @return {!proto.proto.Edge}
  */
-proto.proto.NodeData.deserializeBinary = function(bytes) {
+proto.proto.Edge.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.proto.NodeData;
-  return proto.proto.NodeData.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.proto.Edge;
+  return proto.proto.Edge.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.proto.NodeData} msg The message object to deserialize into.
+ * @param {!proto.proto.Edge} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.proto.NodeData}
+ * @return {!proto.proto.Edge}
  */
-proto.proto.NodeData.deserializeBinaryFromReader = function(msg, reader) {
+proto.proto.Edge.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4124,94 +4415,65 @@ proto.proto.NodeData.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setName(value);
+      msg.setId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setValue(value);
+      msg.setType(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setExpression(value);
+      msg.setLabel(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setIteration(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTabid(value);
       break;
     case 5:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setListvar(value);
+      msg.setSource(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLimit(value);
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTarget(value);
       break;
     case 7:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setUrl(value);
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setSourcex(value);
       break;
     case 8:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMethod(value);
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setSourcey(value);
       break;
     case 9:
-      var value = new proto.proto.NodeDataArray;
-      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
-      msg.setHeaders(value);
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setTargetx(value);
       break;
     case 10:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setPayload(value);
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setTargety(value);
       break;
     case 11:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setVariable(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAnimated(value);
       break;
     case 12:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setMessage(value);
+      var value = new proto.proto.Node;
+      reader.readMessage(value,proto.proto.Node.deserializeBinaryFromReader);
+      msg.setSourcenode(value);
       break;
     case 13:
-      var value = new proto.proto.NodeDataArray;
-      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
-      msg.setList(value);
-      break;
-    case 14:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setListvariable(value);
-      break;
-    case 15:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setTemplate(value);
-      break;
-    case 16:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setText(value);
-      break;
-    case 17:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setPattern(value);
-      break;
-    case 18:
-      var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setReplacetext(value);
+      var value = new proto.proto.Node;
+      reader.readMessage(value,proto.proto.Node.deserializeBinaryFromReader);
+      msg.setTargetnode(value);
       break;
-    case 19:
+    case 14:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setSubprocessid(value);
+      msg.setSourcehandle(value);
       break;
-    case 20:
+    case 15:
       var value = /** @type {string} */ (reader.readStringRequireUtf8());
-      msg.setType(value);
-      break;
-    case 21:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setInterval(value);
-      break;
-    case 22:
-      var value = new proto.proto.NodeDataArray;
-      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
-      msg.setWeeks(value);
+      msg.setTargethandle(value);
       break;
     default:
       reader.skipField();
@@ -4226,9 +4488,9 @@ proto.proto.NodeData.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.proto.NodeData.prototype.serializeBinary = function() {
+proto.proto.Edge.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.proto.NodeData.serializeBinaryToWriter(this, writer);
+  proto.proto.Edge.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4236,178 +4498,2427 @@ proto.proto.NodeData.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.proto.NodeData} message
+ * @param {!proto.proto.Edge} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.NodeData.serializeBinaryToWriter = function(message, writer) {
+proto.proto.Edge.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {string} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
+  f = message.getId();
+  if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getType();
+  if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
+  f = message.getLabel();
+  if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeInt32(
+  f = message.getTabid();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
+  f = message.getSource();
+  if (f.length > 0) {
     writer.writeString(
       5,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeInt32(
+  f = message.getTarget();
+  if (f.length > 0) {
+    writer.writeString(
       6,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeString(
+  f = message.getSourcex();
+  if (f !== 0.0) {
+    writer.writeFloat(
       7,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 8));
-  if (f != null) {
-    writer.writeString(
+  f = message.getSourcey();
+  if (f !== 0.0) {
+    writer.writeFloat(
       8,
       f
     );
   }
-  f = message.getHeaders();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getTargetx();
+  if (f !== 0.0) {
+    writer.writeFloat(
       9,
-      f,
-      proto.proto.NodeDataArray.serializeBinaryToWriter
+      f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 10));
-  if (f != null) {
-    writer.writeString(
+  f = message.getTargety();
+  if (f !== 0.0) {
+    writer.writeFloat(
       10,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 11));
-  if (f != null) {
-    writer.writeString(
+  f = message.getAnimated();
+  if (f) {
+    writer.writeBool(
       11,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 12));
+  f = message.getSourcenode();
   if (f != null) {
-    writer.writeString(
+    writer.writeMessage(
       12,
-      f
+      f,
+      proto.proto.Node.serializeBinaryToWriter
     );
   }
-  f = message.getList();
+  f = message.getTargetnode();
   if (f != null) {
     writer.writeMessage(
       13,
       f,
-      proto.proto.NodeDataArray.serializeBinaryToWriter
+      proto.proto.Node.serializeBinaryToWriter
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 14));
-  if (f != null) {
+  f = message.getSourcehandle();
+  if (f.length > 0) {
     writer.writeString(
       14,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 15));
-  if (f != null) {
+  f = message.getTargethandle();
+  if (f.length > 0) {
     writer.writeString(
       15,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 16));
-  if (f != null) {
-    writer.writeString(
-      16,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 17));
-  if (f != null) {
-    writer.writeString(
-      17,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 18));
-  if (f != null) {
-    writer.writeString(
-      18,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 19));
-  if (f != null) {
-    writer.writeString(
-      19,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 20));
-  if (f != null) {
-    writer.writeString(
-      20,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 21));
-  if (f != null) {
-    writer.writeInt32(
-      21,
-      f
-    );
-  }
-  f = message.getWeeks();
-  if (f != null) {
-    writer.writeMessage(
-      22,
-      f,
-      proto.proto.NodeDataArray.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.proto.Edge.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string type = 2;
+ * @return {string}
+ */
+proto.proto.Edge.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string label = 3;
+ * @return {string}
+ */
+proto.proto.Edge.prototype.getLabel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setLabel = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string tabid = 4;
+ * @return {string}
+ */
+proto.proto.Edge.prototype.getTabid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setTabid = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string source = 5;
+ * @return {string}
+ */
+proto.proto.Edge.prototype.getSource = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setSource = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string target = 6;
+ * @return {string}
+ */
+proto.proto.Edge.prototype.getTarget = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setTarget = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional float sourcex = 7;
+ * @return {number}
+ */
+proto.proto.Edge.prototype.getSourcex = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 7, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setSourcex = function(value) {
+  return jspb.Message.setProto3FloatField(this, 7, value);
+};
+
+
+/**
+ * optional float sourcey = 8;
+ * @return {number}
+ */
+proto.proto.Edge.prototype.getSourcey = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 8, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setSourcey = function(value) {
+  return jspb.Message.setProto3FloatField(this, 8, value);
+};
+
+
+/**
+ * optional float targetx = 9;
+ * @return {number}
+ */
+proto.proto.Edge.prototype.getTargetx = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 9, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setTargetx = function(value) {
+  return jspb.Message.setProto3FloatField(this, 9, value);
+};
+
+
+/**
+ * optional float targety = 10;
+ * @return {number}
+ */
+proto.proto.Edge.prototype.getTargety = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 10, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setTargety = function(value) {
+  return jspb.Message.setProto3FloatField(this, 10, value);
+};
+
+
+/**
+ * optional bool animated = 11;
+ * @return {boolean}
+ */
+proto.proto.Edge.prototype.getAnimated = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 11, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setAnimated = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 11, value);
+};
+
+
+/**
+ * optional Node sourcenode = 12;
+ * @return {?proto.proto.Node}
+ */
+proto.proto.Edge.prototype.getSourcenode = function() {
+  return /** @type{?proto.proto.Node} */ (
+    jspb.Message.getWrapperField(this, proto.proto.Node, 12));
+};
+
+
+/**
+ * @param {?proto.proto.Node|undefined} value
+ * @return {!proto.proto.Edge} returns this
+*/
+proto.proto.Edge.prototype.setSourcenode = function(value) {
+  return jspb.Message.setWrapperField(this, 12, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.clearSourcenode = function() {
+  return this.setSourcenode(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Edge.prototype.hasSourcenode = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional Node targetnode = 13;
+ * @return {?proto.proto.Node}
+ */
+proto.proto.Edge.prototype.getTargetnode = function() {
+  return /** @type{?proto.proto.Node} */ (
+    jspb.Message.getWrapperField(this, proto.proto.Node, 13));
+};
+
+
+/**
+ * @param {?proto.proto.Node|undefined} value
+ * @return {!proto.proto.Edge} returns this
+*/
+proto.proto.Edge.prototype.setTargetnode = function(value) {
+  return jspb.Message.setWrapperField(this, 13, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.clearTargetnode = function() {
+  return this.setTargetnode(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Edge.prototype.hasTargetnode = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * optional string sourcehandle = 14;
+ * @return {string}
+ */
+proto.proto.Edge.prototype.getSourcehandle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setSourcehandle = function(value) {
+  return jspb.Message.setProto3StringField(this, 14, value);
+};
+
+
+/**
+ * optional string targethandle = 15;
+ * @return {string}
+ */
+proto.proto.Edge.prototype.getTargethandle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Edge} returns this
+ */
+proto.proto.Edge.prototype.setTargethandle = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.proto.Node.repeatedFields_ = [5,7,8];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.proto.Node.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.Node.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.Node} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.proto.Node.toObject = function(includeInstance, msg) {
+  var f, obj = {
+id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+nodetype: jspb.Message.getFieldWithDefault(msg, 2, ""),
+label: jspb.Message.getFieldWithDefault(msg, 3, ""),
+data: (f = msg.getData()) && proto.proto.NodeData.toObject(includeInstance, f),
+groupList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+nodestyle: (f = jspb.Message.getField(msg, 6)) == null ? undefined : f,
+inputsList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+outputsList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
+draggable: (f = jspb.Message.getBooleanField(msg, 9)) == null ? undefined : f,
+icon: (f = msg.getIcon()) && proto.proto.NodeIcon.toObject(includeInstance, f),
+position: (f = msg.getPosition()) && proto.proto.NodePosition.toObject(includeInstance, f),
+nodestatus: (f = jspb.Message.getField(msg, 12)) == null ? undefined : f,
+type: (f = jspb.Message.getField(msg, 13)) == null ? undefined : f,
+dimensions: (f = msg.getDimensions()) && proto.proto.NodeDimensions.toObject(includeInstance, f),
+handlebounds: (f = msg.getHandlebounds()) && proto.proto.NodeHandleBounds.toObject(includeInstance, f),
+computedposition: (f = msg.getComputedposition()) && proto.proto.NodePosition.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.Node}
+ */
+proto.proto.Node.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.Node;
+  return proto.proto.Node.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.Node} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.Node}
+ */
+proto.proto.Node.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setNodetype(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setLabel(value);
+      break;
+    case 4:
+      var value = new proto.proto.NodeData;
+      reader.readMessage(value,proto.proto.NodeData.deserializeBinaryFromReader);
+      msg.setData(value);
+      break;
+    case 5:
+      reader.readPackableInt32Into(msg.getGroupList());
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setNodestyle(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addInputs(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addOutputs(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDraggable(value);
+      break;
+    case 10:
+      var value = new proto.proto.NodeIcon;
+      reader.readMessage(value,proto.proto.NodeIcon.deserializeBinaryFromReader);
+      msg.setIcon(value);
+      break;
+    case 11:
+      var value = new proto.proto.NodePosition;
+      reader.readMessage(value,proto.proto.NodePosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setNodestatus(value);
+      break;
+    case 13:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setType(value);
+      break;
+    case 14:
+      var value = new proto.proto.NodeDimensions;
+      reader.readMessage(value,proto.proto.NodeDimensions.deserializeBinaryFromReader);
+      msg.setDimensions(value);
+      break;
+    case 15:
+      var value = new proto.proto.NodeHandleBounds;
+      reader.readMessage(value,proto.proto.NodeHandleBounds.deserializeBinaryFromReader);
+      msg.setHandlebounds(value);
+      break;
+    case 16:
+      var value = new proto.proto.NodePosition;
+      reader.readMessage(value,proto.proto.NodePosition.deserializeBinaryFromReader);
+      msg.setComputedposition(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.proto.Node.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.Node.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.Node} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.proto.Node.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getNodetype();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getLabel();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getData();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.proto.NodeData.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroupList();
+  if (f.length > 0) {
+    writer.writePackedInt32(
+      5,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getInputsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      7,
+      f
+    );
+  }
+  f = message.getOutputsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      8,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getIcon();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.proto.NodeIcon.serializeBinaryToWriter
+    );
+  }
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.proto.NodePosition.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 12));
+  if (f != null) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 13));
+  if (f != null) {
+    writer.writeString(
+      13,
+      f
+    );
+  }
+  f = message.getDimensions();
+  if (f != null) {
+    writer.writeMessage(
+      14,
+      f,
+      proto.proto.NodeDimensions.serializeBinaryToWriter
+    );
+  }
+  f = message.getHandlebounds();
+  if (f != null) {
+    writer.writeMessage(
+      15,
+      f,
+      proto.proto.NodeHandleBounds.serializeBinaryToWriter
+    );
+  }
+  f = message.getComputedposition();
+  if (f != null) {
+    writer.writeMessage(
+      16,
+      f,
+      proto.proto.NodePosition.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.proto.Node.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string nodetype = 2;
+ * @return {string}
+ */
+proto.proto.Node.prototype.getNodetype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setNodetype = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string label = 3;
+ * @return {string}
+ */
+proto.proto.Node.prototype.getLabel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setLabel = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional NodeData data = 4;
+ * @return {?proto.proto.NodeData}
+ */
+proto.proto.Node.prototype.getData = function() {
+  return /** @type{?proto.proto.NodeData} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeData, 4));
+};
+
+
+/**
+ * @param {?proto.proto.NodeData|undefined} value
+ * @return {!proto.proto.Node} returns this
+*/
+proto.proto.Node.prototype.setData = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearData = function() {
+  return this.setData(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasData = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * repeated int32 group = 5;
+ * @return {!Array<number>}
+ */
+proto.proto.Node.prototype.getGroupList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setGroupList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.addGroup = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearGroupList = function() {
+  return this.setGroupList([]);
+};
+
+
+/**
+ * optional string nodestyle = 6;
+ * @return {string}
+ */
+proto.proto.Node.prototype.getNodestyle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setNodestyle = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearNodestyle = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasNodestyle = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * repeated string inputs = 7;
+ * @return {!Array<string>}
+ */
+proto.proto.Node.prototype.getInputsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 7));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setInputsList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.addInputs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearInputsList = function() {
+  return this.setInputsList([]);
+};
+
+
+/**
+ * repeated string outputs = 8;
+ * @return {!Array<string>}
+ */
+proto.proto.Node.prototype.getOutputsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setOutputsList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.addOutputs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearOutputsList = function() {
+  return this.setOutputsList([]);
+};
+
+
+/**
+ * optional bool draggable = 9;
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.getDraggable = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setDraggable = function(value) {
+  return jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearDraggable = function() {
+  return jspb.Message.setField(this, 9, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasDraggable = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional NodeIcon icon = 10;
+ * @return {?proto.proto.NodeIcon}
+ */
+proto.proto.Node.prototype.getIcon = function() {
+  return /** @type{?proto.proto.NodeIcon} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeIcon, 10));
+};
+
+
+/**
+ * @param {?proto.proto.NodeIcon|undefined} value
+ * @return {!proto.proto.Node} returns this
+*/
+proto.proto.Node.prototype.setIcon = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearIcon = function() {
+  return this.setIcon(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasIcon = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional NodePosition position = 11;
+ * @return {?proto.proto.NodePosition}
+ */
+proto.proto.Node.prototype.getPosition = function() {
+  return /** @type{?proto.proto.NodePosition} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodePosition, 11));
+};
+
+
+/**
+ * @param {?proto.proto.NodePosition|undefined} value
+ * @return {!proto.proto.Node} returns this
+*/
+proto.proto.Node.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * optional string nodestatus = 12;
+ * @return {string}
+ */
+proto.proto.Node.prototype.getNodestatus = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setNodestatus = function(value) {
+  return jspb.Message.setField(this, 12, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearNodestatus = function() {
+  return jspb.Message.setField(this, 12, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasNodestatus = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional string type = 13;
+ * @return {string}
+ */
+proto.proto.Node.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.setType = function(value) {
+  return jspb.Message.setField(this, 13, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearType = function() {
+  return jspb.Message.setField(this, 13, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasType = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * optional NodeDimensions dimensions = 14;
+ * @return {?proto.proto.NodeDimensions}
+ */
+proto.proto.Node.prototype.getDimensions = function() {
+  return /** @type{?proto.proto.NodeDimensions} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeDimensions, 14));
+};
+
+
+/**
+ * @param {?proto.proto.NodeDimensions|undefined} value
+ * @return {!proto.proto.Node} returns this
+*/
+proto.proto.Node.prototype.setDimensions = function(value) {
+  return jspb.Message.setWrapperField(this, 14, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearDimensions = function() {
+  return this.setDimensions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasDimensions = function() {
+  return jspb.Message.getField(this, 14) != null;
+};
+
+
+/**
+ * optional NodeHandleBounds handleBounds = 15;
+ * @return {?proto.proto.NodeHandleBounds}
+ */
+proto.proto.Node.prototype.getHandlebounds = function() {
+  return /** @type{?proto.proto.NodeHandleBounds} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeHandleBounds, 15));
+};
+
+
+/**
+ * @param {?proto.proto.NodeHandleBounds|undefined} value
+ * @return {!proto.proto.Node} returns this
+*/
+proto.proto.Node.prototype.setHandlebounds = function(value) {
+  return jspb.Message.setWrapperField(this, 15, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearHandlebounds = function() {
+  return this.setHandlebounds(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasHandlebounds = function() {
+  return jspb.Message.getField(this, 15) != null;
+};
+
+
+/**
+ * optional NodePosition computedPosition = 16;
+ * @return {?proto.proto.NodePosition}
+ */
+proto.proto.Node.prototype.getComputedposition = function() {
+  return /** @type{?proto.proto.NodePosition} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodePosition, 16));
+};
+
+
+/**
+ * @param {?proto.proto.NodePosition|undefined} value
+ * @return {!proto.proto.Node} returns this
+*/
+proto.proto.Node.prototype.setComputedposition = function(value) {
+  return jspb.Message.setWrapperField(this, 16, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.Node} returns this
+ */
+proto.proto.Node.prototype.clearComputedposition = function() {
+  return this.setComputedposition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.Node.prototype.hasComputedposition = function() {
+  return jspb.Message.getField(this, 16) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.proto.NodeData.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.NodeData.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.NodeData} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.proto.NodeData.toObject = function(includeInstance, msg) {
+  var f, obj = {
+name: (f = jspb.Message.getField(msg, 1)) == null ? undefined : f,
+value: (f = jspb.Message.getField(msg, 2)) == null ? undefined : f,
+expression: (f = jspb.Message.getField(msg, 3)) == null ? undefined : f,
+iteration: (f = jspb.Message.getField(msg, 4)) == null ? undefined : f,
+listvar: (f = jspb.Message.getField(msg, 5)) == null ? undefined : f,
+limit: (f = jspb.Message.getField(msg, 6)) == null ? undefined : f,
+url: (f = jspb.Message.getField(msg, 7)) == null ? undefined : f,
+method: (f = jspb.Message.getField(msg, 8)) == null ? undefined : f,
+headers: (f = msg.getHeaders()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
+payload: (f = jspb.Message.getField(msg, 10)) == null ? undefined : f,
+variable: (f = jspb.Message.getField(msg, 11)) == null ? undefined : f,
+message: (f = jspb.Message.getField(msg, 12)) == null ? undefined : f,
+list: (f = msg.getList()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
+listvariable: (f = jspb.Message.getField(msg, 14)) == null ? undefined : f,
+template: (f = jspb.Message.getField(msg, 15)) == null ? undefined : f,
+text: (f = jspb.Message.getField(msg, 16)) == null ? undefined : f,
+pattern: (f = jspb.Message.getField(msg, 17)) == null ? undefined : f,
+replacetext: (f = jspb.Message.getField(msg, 18)) == null ? undefined : f,
+subprocessid: (f = jspb.Message.getField(msg, 19)) == null ? undefined : f,
+type: (f = jspb.Message.getField(msg, 20)) == null ? undefined : f,
+interval: (f = jspb.Message.getField(msg, 21)) == null ? undefined : f,
+weeks: (f = msg.getWeeks()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
+parallel: (f = jspb.Message.getBooleanField(msg, 23)) == null ? undefined : f,
+concurrency: (f = jspb.Message.getField(msg, 24)) == null ? undefined : f,
+mode: (f = jspb.Message.getField(msg, 25)) == null ? undefined : f,
+required: (f = jspb.Message.getField(msg, 26)) == null ? undefined : f,
+mergeas: (f = jspb.Message.getField(msg, 27)) == null ? undefined : f,
+retry: (f = msg.getRetry()) && proto.proto.RetryPolicy.toObject(includeInstance, f),
+timeoutms: (f = jspb.Message.getField(msg, 29)) == null ? undefined : f,
+approvers: (f = msg.getApprovers()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
+cases: (f = msg.getCases()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
+inputs: (f = msg.getInputs()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
+outputs: (f = msg.getOutputs()) && proto.proto.NodeDataArray.toObject(includeInstance, f),
+plaintext: (f = jspb.Message.getBooleanField(msg, 34)) == null ? undefined : f,
+parallelitems: (f = jspb.Message.getBooleanField(msg, 35)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.NodeData}
+ */
+proto.proto.NodeData.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.NodeData;
+  return proto.proto.NodeData.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.NodeData} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.NodeData}
+ */
+proto.proto.NodeData.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setValue(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setExpression(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setIteration(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setListvar(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLimit(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setUrl(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMethod(value);
+      break;
+    case 9:
+      var value = new proto.proto.NodeDataArray;
+      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
+      msg.setHeaders(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setPayload(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setVariable(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMessage(value);
+      break;
+    case 13:
+      var value = new proto.proto.NodeDataArray;
+      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
+      msg.setList(value);
+      break;
+    case 14:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setListvariable(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setTemplate(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setText(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setPattern(value);
+      break;
+    case 18:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setReplacetext(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setSubprocessid(value);
+      break;
+    case 20:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setType(value);
+      break;
+    case 21:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setInterval(value);
+      break;
+    case 22:
+      var value = new proto.proto.NodeDataArray;
+      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
+      msg.setWeeks(value);
+      break;
+    case 23:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setParallel(value);
+      break;
+    case 24:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setConcurrency(value);
+      break;
+    case 25:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMode(value);
+      break;
+    case 26:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRequired(value);
+      break;
+    case 27:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.setMergeas(value);
+      break;
+    case 28:
+      var value = new proto.proto.RetryPolicy;
+      reader.readMessage(value,proto.proto.RetryPolicy.deserializeBinaryFromReader);
+      msg.setRetry(value);
+      break;
+    case 29:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTimeoutms(value);
+      break;
+    case 30:
+      var value = new proto.proto.NodeDataArray;
+      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
+      msg.setApprovers(value);
+      break;
+    case 31:
+      var value = new proto.proto.NodeDataArray;
+      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
+      msg.setCases(value);
+      break;
+    case 32:
+      var value = new proto.proto.NodeDataArray;
+      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
+      msg.setInputs(value);
+      break;
+    case 33:
+      var value = new proto.proto.NodeDataArray;
+      reader.readMessage(value,proto.proto.NodeDataArray.deserializeBinaryFromReader);
+      msg.setOutputs(value);
+      break;
+    case 34:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPlaintext(value);
+      break;
+    case 35:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setParallelitems(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.proto.NodeData.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.NodeData.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.NodeData} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.proto.NodeData.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getHeaders();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.proto.NodeDataArray.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 11));
+  if (f != null) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 12));
+  if (f != null) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getList();
+  if (f != null) {
+    writer.writeMessage(
+      13,
+      f,
+      proto.proto.NodeDataArray.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 14));
+  if (f != null) {
+    writer.writeString(
+      14,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 15));
+  if (f != null) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 16));
+  if (f != null) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 17));
+  if (f != null) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 18));
+  if (f != null) {
+    writer.writeString(
+      18,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 19));
+  if (f != null) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 20));
+  if (f != null) {
+    writer.writeString(
+      20,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 21));
+  if (f != null) {
+    writer.writeInt32(
+      21,
+      f
+    );
+  }
+  f = message.getWeeks();
+  if (f != null) {
+    writer.writeMessage(
+      22,
+      f,
+      proto.proto.NodeDataArray.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 23));
+  if (f != null) {
+    writer.writeBool(
+      23,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 24));
+  if (f != null) {
+    writer.writeInt32(
+      24,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 25));
+  if (f != null) {
+    writer.writeString(
+      25,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 26));
+  if (f != null) {
+    writer.writeInt32(
+      26,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 27));
+  if (f != null) {
+    writer.writeString(
+      27,
+      f
+    );
+  }
+  f = message.getRetry();
+  if (f != null) {
+    writer.writeMessage(
+      28,
+      f,
+      proto.proto.RetryPolicy.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 29));
+  if (f != null) {
+    writer.writeInt32(
+      29,
+      f
+    );
+  }
+  f = message.getApprovers();
+  if (f != null) {
+    writer.writeMessage(
+      30,
+      f,
+      proto.proto.NodeDataArray.serializeBinaryToWriter
+    );
+  }
+  f = message.getCases();
+  if (f != null) {
+    writer.writeMessage(
+      31,
+      f,
+      proto.proto.NodeDataArray.serializeBinaryToWriter
+    );
+  }
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
+      32,
+      f,
+      proto.proto.NodeDataArray.serializeBinaryToWriter
+    );
+  }
+  f = message.getOutputs();
+  if (f != null) {
+    writer.writeMessage(
+      33,
+      f,
+      proto.proto.NodeDataArray.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 34));
+  if (f != null) {
+    writer.writeBool(
+      34,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 35));
+  if (f != null) {
+    writer.writeBool(
+      35,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setName = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearName = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasName = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string value = 2;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setValue = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearValue = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasValue = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string expression = 3;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getExpression = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setExpression = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearExpression = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasExpression = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional int32 iteration = 4;
+ * @return {number}
+ */
+proto.proto.NodeData.prototype.getIteration = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setIteration = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearIteration = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasIteration = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string listvar = 5;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getListvar = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setListvar = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearListvar = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasListvar = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional int32 limit = 6;
+ * @return {number}
+ */
+proto.proto.NodeData.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setLimit = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearLimit = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasLimit = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional string url = 7;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setUrl = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearUrl = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasUrl = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional string method = 8;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getMethod = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setMethod = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearMethod = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasMethod = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional NodeDataArray headers = 9;
+ * @return {?proto.proto.NodeDataArray}
+ */
+proto.proto.NodeData.prototype.getHeaders = function() {
+  return /** @type{?proto.proto.NodeDataArray} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 9));
+};
+
+
+/**
+ * @param {?proto.proto.NodeDataArray|undefined} value
+ * @return {!proto.proto.NodeData} returns this
+*/
+proto.proto.NodeData.prototype.setHeaders = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearHeaders = function() {
+  return this.setHeaders(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasHeaders = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional string payload = 10;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getPayload = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setPayload = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearPayload = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasPayload = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional string variable = 11;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getVariable = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setVariable = function(value) {
+  return jspb.Message.setField(this, 11, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearVariable = function() {
+  return jspb.Message.setField(this, 11, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasVariable = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * optional string message = 12;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setMessage = function(value) {
+  return jspb.Message.setField(this, 12, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearMessage = function() {
+  return jspb.Message.setField(this, 12, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasMessage = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional NodeDataArray list = 13;
+ * @return {?proto.proto.NodeDataArray}
+ */
+proto.proto.NodeData.prototype.getList = function() {
+  return /** @type{?proto.proto.NodeDataArray} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 13));
+};
+
+
+/**
+ * @param {?proto.proto.NodeDataArray|undefined} value
+ * @return {!proto.proto.NodeData} returns this
+*/
+proto.proto.NodeData.prototype.setList = function(value) {
+  return jspb.Message.setWrapperField(this, 13, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearList = function() {
+  return this.setList(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasList = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * optional string listVariable = 14;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getListvariable = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setListvariable = function(value) {
+  return jspb.Message.setField(this, 14, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearListvariable = function() {
+  return jspb.Message.setField(this, 14, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasListvariable = function() {
+  return jspb.Message.getField(this, 14) != null;
+};
+
+
+/**
+ * optional string template = 15;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getTemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setTemplate = function(value) {
+  return jspb.Message.setField(this, 15, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearTemplate = function() {
+  return jspb.Message.setField(this, 15, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasTemplate = function() {
+  return jspb.Message.getField(this, 15) != null;
+};
+
+
+/**
+ * optional string text = 16;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setText = function(value) {
+  return jspb.Message.setField(this, 16, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearText = function() {
+  return jspb.Message.setField(this, 16, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasText = function() {
+  return jspb.Message.getField(this, 16) != null;
+};
+
+
+/**
+ * optional string pattern = 17;
+ * @return {string}
+ */
+proto.proto.NodeData.prototype.getPattern = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.setPattern = function(value) {
+  return jspb.Message.setField(this, 17, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.proto.NodeData} returns this
+ */
+proto.proto.NodeData.prototype.clearPattern = function() {
+  return jspb.Message.setField(this, 17, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasPattern = function() {
+  return jspb.Message.getField(this, 17) != null;
+};
+
+
+/**
+ * optional string replaceText = 18;
  * @return {string}
  */
-proto.proto.NodeData.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.proto.NodeData.prototype.getReplacetext = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
 };
 
 
@@ -4415,8 +6926,8 @@ proto.proto.NodeData.prototype.getName = function() {
  * @param {string} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setName = function(value) {
-  return jspb.Message.setField(this, 1, value);
+proto.proto.NodeData.prototype.setReplacetext = function(value) {
+  return jspb.Message.setField(this, 18, value);
 };
 
 
@@ -4424,8 +6935,8 @@ proto.proto.NodeData.prototype.setName = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearName = function() {
-  return jspb.Message.setField(this, 1, undefined);
+proto.proto.NodeData.prototype.clearReplacetext = function() {
+  return jspb.Message.setField(this, 18, undefined);
 };
 
 
@@ -4433,17 +6944,17 @@ proto.proto.NodeData.prototype.clearName = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasName = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.proto.NodeData.prototype.hasReplacetext = function() {
+  return jspb.Message.getField(this, 18) != null;
 };
 
 
 /**
- * optional string value = 2;
+ * optional string subProcessId = 19;
  * @return {string}
  */
-proto.proto.NodeData.prototype.getValue = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.proto.NodeData.prototype.getSubprocessid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
 };
 
 
@@ -4451,8 +6962,8 @@ proto.proto.NodeData.prototype.getValue = function() {
  * @param {string} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setValue = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.proto.NodeData.prototype.setSubprocessid = function(value) {
+  return jspb.Message.setField(this, 19, value);
 };
 
 
@@ -4460,8 +6971,8 @@ proto.proto.NodeData.prototype.setValue = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearValue = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.proto.NodeData.prototype.clearSubprocessid = function() {
+  return jspb.Message.setField(this, 19, undefined);
 };
 
 
@@ -4469,17 +6980,17 @@ proto.proto.NodeData.prototype.clearValue = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasValue = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.proto.NodeData.prototype.hasSubprocessid = function() {
+  return jspb.Message.getField(this, 19) != null;
 };
 
 
 /**
- * optional string expression = 3;
+ * optional string type = 20;
  * @return {string}
  */
-proto.proto.NodeData.prototype.getExpression = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.proto.NodeData.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
 };
 
 
@@ -4487,8 +6998,8 @@ proto.proto.NodeData.prototype.getExpression = function() {
  * @param {string} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setExpression = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.proto.NodeData.prototype.setType = function(value) {
+  return jspb.Message.setField(this, 20, value);
 };
 
 
@@ -4496,8 +7007,8 @@ proto.proto.NodeData.prototype.setExpression = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearExpression = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.proto.NodeData.prototype.clearType = function() {
+  return jspb.Message.setField(this, 20, undefined);
 };
 
 
@@ -4505,17 +7016,17 @@ proto.proto.NodeData.prototype.clearExpression = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasExpression = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.proto.NodeData.prototype.hasType = function() {
+  return jspb.Message.getField(this, 20) != null;
 };
 
 
 /**
- * optional int32 iteration = 4;
+ * optional int32 interval = 21;
  * @return {number}
  */
-proto.proto.NodeData.prototype.getIteration = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.proto.NodeData.prototype.getInterval = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 21, 0));
 };
 
 
@@ -4523,8 +7034,8 @@ proto.proto.NodeData.prototype.getIteration = function() {
  * @param {number} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setIteration = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.proto.NodeData.prototype.setInterval = function(value) {
+  return jspb.Message.setField(this, 21, value);
 };
 
 
@@ -4532,8 +7043,8 @@ proto.proto.NodeData.prototype.setIteration = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearIteration = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.proto.NodeData.prototype.clearInterval = function() {
+  return jspb.Message.setField(this, 21, undefined);
 };
 
 
@@ -4541,35 +7052,36 @@ proto.proto.NodeData.prototype.clearIteration = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasIteration = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.proto.NodeData.prototype.hasInterval = function() {
+  return jspb.Message.getField(this, 21) != null;
 };
 
 
 /**
- * optional string listvar = 5;
- * @return {string}
+ * optional NodeDataArray weeks = 22;
+ * @return {?proto.proto.NodeDataArray}
  */
-proto.proto.NodeData.prototype.getListvar = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.proto.NodeData.prototype.getWeeks = function() {
+  return /** @type{?proto.proto.NodeDataArray} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 22));
 };
 
 
 /**
- * @param {string} value
+ * @param {?proto.proto.NodeDataArray|undefined} value
  * @return {!proto.proto.NodeData} returns this
- */
-proto.proto.NodeData.prototype.setListvar = function(value) {
-  return jspb.Message.setField(this, 5, value);
+*/
+proto.proto.NodeData.prototype.setWeeks = function(value) {
+  return jspb.Message.setWrapperField(this, 22, value);
 };
 
 
 /**
- * Clears the field making it undefined.
+ * Clears the message field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearListvar = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.proto.NodeData.prototype.clearWeeks = function() {
+  return this.setWeeks(undefined);
 };
 
 
@@ -4577,26 +7089,26 @@ proto.proto.NodeData.prototype.clearListvar = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasListvar = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.proto.NodeData.prototype.hasWeeks = function() {
+  return jspb.Message.getField(this, 22) != null;
 };
 
 
 /**
- * optional int32 limit = 6;
- * @return {number}
+ * optional bool parallel = 23;
+ * @return {boolean}
  */
-proto.proto.NodeData.prototype.getLimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.proto.NodeData.prototype.getParallel = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 23, false));
 };
 
 
 /**
- * @param {number} value
+ * @param {boolean} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setLimit = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.proto.NodeData.prototype.setParallel = function(value) {
+  return jspb.Message.setField(this, 23, value);
 };
 
 
@@ -4604,8 +7116,8 @@ proto.proto.NodeData.prototype.setLimit = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearLimit = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.proto.NodeData.prototype.clearParallel = function() {
+  return jspb.Message.setField(this, 23, undefined);
 };
 
 
@@ -4613,26 +7125,26 @@ proto.proto.NodeData.prototype.clearLimit = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasLimit = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.proto.NodeData.prototype.hasParallel = function() {
+  return jspb.Message.getField(this, 23) != null;
 };
 
 
 /**
- * optional string url = 7;
- * @return {string}
+ * optional int32 concurrency = 24;
+ * @return {number}
  */
-proto.proto.NodeData.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.proto.NodeData.prototype.getConcurrency = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 24, 0));
 };
 
 
 /**
- * @param {string} value
+ * @param {number} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setUrl = function(value) {
-  return jspb.Message.setField(this, 7, value);
+proto.proto.NodeData.prototype.setConcurrency = function(value) {
+  return jspb.Message.setField(this, 24, value);
 };
 
 
@@ -4640,8 +7152,8 @@ proto.proto.NodeData.prototype.setUrl = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearUrl = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.proto.NodeData.prototype.clearConcurrency = function() {
+  return jspb.Message.setField(this, 24, undefined);
 };
 
 
@@ -4649,17 +7161,17 @@ proto.proto.NodeData.prototype.clearUrl = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasUrl = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.proto.NodeData.prototype.hasConcurrency = function() {
+  return jspb.Message.getField(this, 24) != null;
 };
 
 
 /**
- * optional string method = 8;
+ * optional string mode = 25;
  * @return {string}
  */
-proto.proto.NodeData.prototype.getMethod = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.proto.NodeData.prototype.getMode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 25, ""));
 };
 
 
@@ -4667,8 +7179,8 @@ proto.proto.NodeData.prototype.getMethod = function() {
  * @param {string} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setMethod = function(value) {
-  return jspb.Message.setField(this, 8, value);
+proto.proto.NodeData.prototype.setMode = function(value) {
+  return jspb.Message.setField(this, 25, value);
 };
 
 
@@ -4676,8 +7188,8 @@ proto.proto.NodeData.prototype.setMethod = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearMethod = function() {
-  return jspb.Message.setField(this, 8, undefined);
+proto.proto.NodeData.prototype.clearMode = function() {
+  return jspb.Message.setField(this, 25, undefined);
 };
 
 
@@ -4685,36 +7197,35 @@ proto.proto.NodeData.prototype.clearMethod = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasMethod = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.proto.NodeData.prototype.hasMode = function() {
+  return jspb.Message.getField(this, 25) != null;
 };
 
 
 /**
- * optional NodeDataArray headers = 9;
- * @return {?proto.proto.NodeDataArray}
+ * optional int32 required = 26;
+ * @return {number}
  */
-proto.proto.NodeData.prototype.getHeaders = function() {
-  return /** @type{?proto.proto.NodeDataArray} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 9));
+proto.proto.NodeData.prototype.getRequired = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 26, 0));
 };
 
 
 /**
- * @param {?proto.proto.NodeDataArray|undefined} value
+ * @param {number} value
  * @return {!proto.proto.NodeData} returns this
-*/
-proto.proto.NodeData.prototype.setHeaders = function(value) {
-  return jspb.Message.setWrapperField(this, 9, value);
+ */
+proto.proto.NodeData.prototype.setRequired = function(value) {
+  return jspb.Message.setField(this, 26, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
+ * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearHeaders = function() {
-  return this.setHeaders(undefined);
+proto.proto.NodeData.prototype.clearRequired = function() {
+  return jspb.Message.setField(this, 26, undefined);
 };
 
 
@@ -4722,17 +7233,17 @@ proto.proto.NodeData.prototype.clearHeaders = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasHeaders = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.proto.NodeData.prototype.hasRequired = function() {
+  return jspb.Message.getField(this, 26) != null;
 };
 
 
 /**
- * optional string payload = 10;
+ * optional string mergeAs = 27;
  * @return {string}
  */
-proto.proto.NodeData.prototype.getPayload = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+proto.proto.NodeData.prototype.getMergeas = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 27, ""));
 };
 
 
@@ -4740,8 +7251,8 @@ proto.proto.NodeData.prototype.getPayload = function() {
  * @param {string} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setPayload = function(value) {
-  return jspb.Message.setField(this, 10, value);
+proto.proto.NodeData.prototype.setMergeas = function(value) {
+  return jspb.Message.setField(this, 27, value);
 };
 
 
@@ -4749,8 +7260,8 @@ proto.proto.NodeData.prototype.setPayload = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearPayload = function() {
-  return jspb.Message.setField(this, 10, undefined);
+proto.proto.NodeData.prototype.clearMergeas = function() {
+  return jspb.Message.setField(this, 27, undefined);
 };
 
 
@@ -4758,35 +7269,36 @@ proto.proto.NodeData.prototype.clearPayload = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasPayload = function() {
-  return jspb.Message.getField(this, 10) != null;
+proto.proto.NodeData.prototype.hasMergeas = function() {
+  return jspb.Message.getField(this, 27) != null;
 };
 
 
 /**
- * optional string variable = 11;
- * @return {string}
+ * optional RetryPolicy retry = 28;
+ * @return {?proto.proto.RetryPolicy}
  */
-proto.proto.NodeData.prototype.getVariable = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.proto.NodeData.prototype.getRetry = function() {
+  return /** @type{?proto.proto.RetryPolicy} */ (
+    jspb.Message.getWrapperField(this, proto.proto.RetryPolicy, 28));
 };
 
 
 /**
- * @param {string} value
+ * @param {?proto.proto.RetryPolicy|undefined} value
  * @return {!proto.proto.NodeData} returns this
- */
-proto.proto.NodeData.prototype.setVariable = function(value) {
-  return jspb.Message.setField(this, 11, value);
+*/
+proto.proto.NodeData.prototype.setRetry = function(value) {
+  return jspb.Message.setWrapperField(this, 28, value);
 };
 
 
 /**
- * Clears the field making it undefined.
+ * Clears the message field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearVariable = function() {
-  return jspb.Message.setField(this, 11, undefined);
+proto.proto.NodeData.prototype.clearRetry = function() {
+  return this.setRetry(undefined);
 };
 
 
@@ -4794,26 +7306,26 @@ proto.proto.NodeData.prototype.clearVariable = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasVariable = function() {
-  return jspb.Message.getField(this, 11) != null;
+proto.proto.NodeData.prototype.hasRetry = function() {
+  return jspb.Message.getField(this, 28) != null;
 };
 
 
 /**
- * optional string message = 12;
- * @return {string}
+ * optional int32 timeoutMs = 29;
+ * @return {number}
  */
-proto.proto.NodeData.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.proto.NodeData.prototype.getTimeoutms = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 29, 0));
 };
 
 
 /**
- * @param {string} value
+ * @param {number} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setMessage = function(value) {
-  return jspb.Message.setField(this, 12, value);
+proto.proto.NodeData.prototype.setTimeoutms = function(value) {
+  return jspb.Message.setField(this, 29, value);
 };
 
 
@@ -4821,8 +7333,8 @@ proto.proto.NodeData.prototype.setMessage = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearMessage = function() {
-  return jspb.Message.setField(this, 12, undefined);
+proto.proto.NodeData.prototype.clearTimeoutms = function() {
+  return jspb.Message.setField(this, 29, undefined);
 };
 
 
@@ -4830,18 +7342,18 @@ proto.proto.NodeData.prototype.clearMessage = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasMessage = function() {
-  return jspb.Message.getField(this, 12) != null;
+proto.proto.NodeData.prototype.hasTimeoutms = function() {
+  return jspb.Message.getField(this, 29) != null;
 };
 
 
 /**
- * optional NodeDataArray list = 13;
+ * optional NodeDataArray approvers = 30;
  * @return {?proto.proto.NodeDataArray}
  */
-proto.proto.NodeData.prototype.getList = function() {
+proto.proto.NodeData.prototype.getApprovers = function() {
   return /** @type{?proto.proto.NodeDataArray} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 13));
+    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 30));
 };
 
 
@@ -4849,8 +7361,8 @@ proto.proto.NodeData.prototype.getList = function() {
  * @param {?proto.proto.NodeDataArray|undefined} value
  * @return {!proto.proto.NodeData} returns this
 */
-proto.proto.NodeData.prototype.setList = function(value) {
-  return jspb.Message.setWrapperField(this, 13, value);
+proto.proto.NodeData.prototype.setApprovers = function(value) {
+  return jspb.Message.setWrapperField(this, 30, value);
 };
 
 
@@ -4858,8 +7370,8 @@ proto.proto.NodeData.prototype.setList = function(value) {
  * Clears the message field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearList = function() {
-  return this.setList(undefined);
+proto.proto.NodeData.prototype.clearApprovers = function() {
+  return this.setApprovers(undefined);
 };
 
 
@@ -4867,35 +7379,73 @@ proto.proto.NodeData.prototype.clearList = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasList = function() {
-  return jspb.Message.getField(this, 13) != null;
+proto.proto.NodeData.prototype.hasApprovers = function() {
+  return jspb.Message.getField(this, 30) != null;
 };
 
 
 /**
- * optional string listVariable = 14;
- * @return {string}
+ * optional NodeDataArray cases = 31;
+ * @return {?proto.proto.NodeDataArray}
  */
-proto.proto.NodeData.prototype.getListvariable = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+proto.proto.NodeData.prototype.getCases = function() {
+  return /** @type{?proto.proto.NodeDataArray} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 31));
 };
 
 
 /**
- * @param {string} value
+ * @param {?proto.proto.NodeDataArray|undefined} value
+ * @return {!proto.proto.NodeData} returns this
+*/
+proto.proto.NodeData.prototype.setCases = function(value) {
+  return jspb.Message.setWrapperField(this, 31, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setListvariable = function(value) {
-  return jspb.Message.setField(this, 14, value);
+proto.proto.NodeData.prototype.clearCases = function() {
+  return this.setCases(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.proto.NodeData.prototype.hasCases = function() {
+  return jspb.Message.getField(this, 31) != null;
+};
+
+
+/**
+ * optional NodeDataArray inputs = 32;
+ * @return {?proto.proto.NodeDataArray}
+ */
+proto.proto.NodeData.prototype.getInputs = function() {
+  return /** @type{?proto.proto.NodeDataArray} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 32));
+};
+
+
+/**
+ * @param {?proto.proto.NodeDataArray|undefined} value
+ * @return {!proto.proto.NodeData} returns this
+*/
+proto.proto.NodeData.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 32, value);
 };
 
 
 /**
- * Clears the field making it undefined.
+ * Clears the message field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearListvariable = function() {
-  return jspb.Message.setField(this, 14, undefined);
+proto.proto.NodeData.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
 };
 
 
@@ -4903,35 +7453,36 @@ proto.proto.NodeData.prototype.clearListvariable = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasListvariable = function() {
-  return jspb.Message.getField(this, 14) != null;
+proto.proto.NodeData.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 32) != null;
 };
 
 
 /**
- * optional string template = 15;
- * @return {string}
+ * optional NodeDataArray outputs = 33;
+ * @return {?proto.proto.NodeDataArray}
  */
-proto.proto.NodeData.prototype.getTemplate = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+proto.proto.NodeData.prototype.getOutputs = function() {
+  return /** @type{?proto.proto.NodeDataArray} */ (
+    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 33));
 };
 
 
 /**
- * @param {string} value
+ * @param {?proto.proto.NodeDataArray|undefined} value
  * @return {!proto.proto.NodeData} returns this
- */
-proto.proto.NodeData.prototype.setTemplate = function(value) {
-  return jspb.Message.setField(this, 15, value);
+*/
+proto.proto.NodeData.prototype.setOutputs = function(value) {
+  return jspb.Message.setWrapperField(this, 33, value);
 };
 
 
 /**
- * Clears the field making it undefined.
+ * Clears the message field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearTemplate = function() {
-  return jspb.Message.setField(this, 15, undefined);
+proto.proto.NodeData.prototype.clearOutputs = function() {
+  return this.setOutputs(undefined);
 };
 
 
@@ -4939,26 +7490,26 @@ proto.proto.NodeData.prototype.clearTemplate = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasTemplate = function() {
-  return jspb.Message.getField(this, 15) != null;
+proto.proto.NodeData.prototype.hasOutputs = function() {
+  return jspb.Message.getField(this, 33) != null;
 };
 
 
 /**
- * optional string text = 16;
- * @return {string}
+ * optional bool plainText = 34;
+ * @return {boolean}
  */
-proto.proto.NodeData.prototype.getText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+proto.proto.NodeData.prototype.getPlaintext = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 34, false));
 };
 
 
 /**
- * @param {string} value
+ * @param {boolean} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setText = function(value) {
-  return jspb.Message.setField(this, 16, value);
+proto.proto.NodeData.prototype.setPlaintext = function(value) {
+  return jspb.Message.setField(this, 34, value);
 };
 
 
@@ -4966,8 +7517,8 @@ proto.proto.NodeData.prototype.setText = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearText = function() {
-  return jspb.Message.setField(this, 16, undefined);
+proto.proto.NodeData.prototype.clearPlaintext = function() {
+  return jspb.Message.setField(this, 34, undefined);
 };
 
 
@@ -4975,26 +7526,26 @@ proto.proto.NodeData.prototype.clearText = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasText = function() {
-  return jspb.Message.getField(this, 16) != null;
+proto.proto.NodeData.prototype.hasPlaintext = function() {
+  return jspb.Message.getField(this, 34) != null;
 };
 
 
 /**
- * optional string pattern = 17;
- * @return {string}
+ * optional bool parallelItems = 35;
+ * @return {boolean}
  */
-proto.proto.NodeData.prototype.getPattern = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+proto.proto.NodeData.prototype.getParallelitems = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 35, false));
 };
 
 
 /**
- * @param {string} value
+ * @param {boolean} value
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.setPattern = function(value) {
-  return jspb.Message.setField(this, 17, value);
+proto.proto.NodeData.prototype.setParallelitems = function(value) {
+  return jspb.Message.setField(this, 35, value);
 };
 
 
@@ -5002,8 +7553,8 @@ proto.proto.NodeData.prototype.setPattern = function(value) {
  * Clears the field making it undefined.
  * @return {!proto.proto.NodeData} returns this
  */
-proto.proto.NodeData.prototype.clearPattern = function() {
-  return jspb.Message.setField(this, 17, undefined);
+proto.proto.NodeData.prototype.clearParallelitems = function() {
+  return jspb.Message.setField(this, 35, undefined);
 };
 
 
@@ -5011,189 +7562,284 @@ proto.proto.NodeData.prototype.clearPattern = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.proto.NodeData.prototype.hasPattern = function() {
-  return jspb.Message.getField(this, 17) != null;
+proto.proto.NodeData.prototype.hasParallelitems = function() {
+  return jspb.Message.getField(this, 35) != null;
 };
 
 
-/**
- * optional string replaceText = 18;
- * @return {string}
- */
-proto.proto.NodeData.prototype.getReplacetext = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.proto.NodeData} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.proto.NodeData.prototype.setReplacetext = function(value) {
-  return jspb.Message.setField(this, 18, value);
-};
+proto.proto.RetryPolicy.repeatedFields_ = [5];
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the field making it undefined.
- * @return {!proto.proto.NodeData} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.proto.NodeData.prototype.clearReplacetext = function() {
-  return jspb.Message.setField(this, 18, undefined);
+proto.proto.RetryPolicy.prototype.toObject = function(opt_includeInstance) {
+  return proto.proto.RetryPolicy.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.proto.RetryPolicy} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.NodeData.prototype.hasReplacetext = function() {
-  return jspb.Message.getField(this, 18) != null;
+proto.proto.RetryPolicy.toObject = function(includeInstance, msg) {
+  var f, obj = {
+maxattempts: jspb.Message.getFieldWithDefault(msg, 1, 0),
+initialdelayms: jspb.Message.getFieldWithDefault(msg, 2, 0),
+multiplier: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+maxdelayms: jspb.Message.getFieldWithDefault(msg, 4, 0),
+retryonList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string subProcessId = 19;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.binary.bytesource.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.proto.RetryPolicy}
  */
-proto.proto.NodeData.prototype.getSubprocessid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+proto.proto.RetryPolicy.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.proto.RetryPolicy;
+  return proto.proto.RetryPolicy.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.NodeData} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.proto.RetryPolicy} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.proto.RetryPolicy}
  */
-proto.proto.NodeData.prototype.setSubprocessid = function(value) {
-  return jspb.Message.setField(this, 19, value);
+proto.proto.RetryPolicy.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxattempts(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setInitialdelayms(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMultiplier(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxdelayms(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readStringRequireUtf8());
+      msg.addRetryon(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.proto.NodeData} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.proto.NodeData.prototype.clearSubprocessid = function() {
-  return jspb.Message.setField(this, 19, undefined);
+proto.proto.RetryPolicy.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.proto.RetryPolicy.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.proto.RetryPolicy} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.proto.NodeData.prototype.hasSubprocessid = function() {
-  return jspb.Message.getField(this, 19) != null;
+proto.proto.RetryPolicy.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMaxattempts();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getInitialdelayms();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getMultiplier();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getMaxdelayms();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getRetryonList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string type = 20;
- * @return {string}
+ * optional int32 maxAttempts = 1;
+ * @return {number}
  */
-proto.proto.NodeData.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
+proto.proto.RetryPolicy.prototype.getMaxattempts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.proto.NodeData} returns this
+ * @param {number} value
+ * @return {!proto.proto.RetryPolicy} returns this
  */
-proto.proto.NodeData.prototype.setType = function(value) {
-  return jspb.Message.setField(this, 20, value);
+proto.proto.RetryPolicy.prototype.setMaxattempts = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.proto.NodeData} returns this
+ * optional int32 initialDelayMs = 2;
+ * @return {number}
  */
-proto.proto.NodeData.prototype.clearType = function() {
-  return jspb.Message.setField(this, 20, undefined);
+proto.proto.RetryPolicy.prototype.getInitialdelayms = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.proto.RetryPolicy} returns this
  */
-proto.proto.NodeData.prototype.hasType = function() {
-  return jspb.Message.getField(this, 20) != null;
+proto.proto.RetryPolicy.prototype.setInitialdelayms = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 interval = 21;
+ * optional double multiplier = 3;
  * @return {number}
  */
-proto.proto.NodeData.prototype.getInterval = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 21, 0));
+proto.proto.RetryPolicy.prototype.getMultiplier = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.proto.NodeData} returns this
+ * @return {!proto.proto.RetryPolicy} returns this
  */
-proto.proto.NodeData.prototype.setInterval = function(value) {
-  return jspb.Message.setField(this, 21, value);
+proto.proto.RetryPolicy.prototype.setMultiplier = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.proto.NodeData} returns this
+ * optional int32 maxDelayMs = 4;
+ * @return {number}
  */
-proto.proto.NodeData.prototype.clearInterval = function() {
-  return jspb.Message.setField(this, 21, undefined);
+proto.proto.RetryPolicy.prototype.getMaxdelayms = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.proto.RetryPolicy} returns this
  */
-proto.proto.NodeData.prototype.hasInterval = function() {
-  return jspb.Message.getField(this, 21) != null;
+proto.proto.RetryPolicy.prototype.setMaxdelayms = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional NodeDataArray weeks = 22;
- * @return {?proto.proto.NodeDataArray}
+ * repeated string retryOn = 5;
+ * @return {!Array<string>}
  */
-proto.proto.NodeData.prototype.getWeeks = function() {
-  return /** @type{?proto.proto.NodeDataArray} */ (
-    jspb.Message.getWrapperField(this, proto.proto.NodeDataArray, 22));
+proto.proto.RetryPolicy.prototype.getRetryonList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
 };
 
 
 /**
- * @param {?proto.proto.NodeDataArray|undefined} value
- * @return {!proto.proto.NodeData} returns this
-*/
-proto.proto.NodeData.prototype.setWeeks = function(value) {
-  return jspb.Message.setWrapperField(this, 22, value);
+ * @param {!Array<string>} value
+ * @return {!proto.proto.RetryPolicy} returns this
+ */
+proto.proto.RetryPolicy.prototype.setRetryonList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.proto.NodeData} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.proto.RetryPolicy} returns this
  */
-proto.proto.NodeData.prototype.clearWeeks = function() {
-  return this.setWeeks(undefined);
+proto.proto.RetryPolicy.prototype.addRetryon = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.proto.RetryPolicy} returns this
  */
-proto.proto.NodeData.prototype.hasWeeks = function() {
-  return jspb.Message.getField(this, 22) != null;
+proto.proto.RetryPolicy.prototype.clearRetryonList = function() {
+  return this.setRetryonList([]);
 };
 
 
@@ -6790,7 +9436,10 @@ proto.proto.NodeStatus = {
   RUNNING: 0,
   COMPLETED: 1,
   FAILED: 2,
-  INFO: 3
+  INFO: 3,
+  CANCELLED: 4,
+  WAITING: 5,
+  PAUSED: 6
 };
 
 goog.object.extend(exports, proto.proto);
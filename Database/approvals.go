@@ -0,0 +1,88 @@
+package DB
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+const (
+	ApprovalPending  = ""
+	ApprovalApproved = "approved"
+	ApprovalRejected = "rejected"
+	ApprovalTimeout  = "timeout"
+)
+
+// Approval is a sign-off requested by an approval node. Approvers holds the
+// usernames, user ids or roles allowed to decide; an empty list allows any
+// signed-in user. Consumed is set once the run has moved past the decision.
+type Approval struct {
+	ID        string `gorm:"primaryKey"`
+	ProcessID string `gorm:"index"`
+	NodeID    string
+	Approvers pq.StringArray `gorm:"type:text[]"`
+	Decision  string
+	DecidedBy string
+	Comment   string
+	Consumed  bool
+	ExpiresAt int64
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+func (db *DatabaseConnection) CreateApproval(approval *Approval) error {
+	approval.CreatedAt = time.Now().UTC().Unix()
+	approval.UpdatedAt = approval.CreatedAt
+	if approval.ID == "" {
+		approval.ID = uuid.NewString()
+	}
+	return db.conn.Create(approval).Error
+}
+
+// GetOpenApproval returns the latest approval of a node that the run has not
+// moved past yet.
+func (db *DatabaseConnection) GetOpenApproval(processID, nodeID string) (Approval, bool, error) {
+	var approval Approval
+	err := db.conn.Where("process_id = ? AND node_id = ? AND consumed = ?", processID, nodeID, false).Order("created_at DESC").First(&approval).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Approval{}, false, nil
+	}
+	if err != nil {
+		return Approval{}, false, err
+	}
+	return approval, true, nil
+}
+
+// DecideApproval records a decision on a pending approval and reports whether
+// it was still pending.
+func (db *DatabaseConnection) DecideApproval(id, decision, decidedBy, comment string) (bool, error) {
+	result := db.conn.Model(&Approval{}).Where("id = ? AND decision = ?", id, ApprovalPending).Updates(map[string]interface{}{
+		"decision":   decision,
+		"decided_by": decidedBy,
+		"comment":    comment,
+		"updated_at": time.Now().UTC().Unix(),
+	})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (db *DatabaseConnection) ConsumeApproval(id string) error {
+	return db.conn.Model(&Approval{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"consumed":   true,
+		"updated_at": time.Now().UTC().Unix(),
+	}).Error
+}
+
+// CloseApprovals marks every approval of a run that it has not moved past as
+// consumed, once the run can no longer act on them.
+func (db *DatabaseConnection) CloseApprovals(processID string) error {
+	return db.conn.Model(&Approval{}).Where("process_id = ? AND consumed = ?", processID, false).Updates(map[string]interface{}{
+		"consumed":   true,
+		"updated_at": time.Now().UTC().Unix(),
+	}).Error
+}
@@ -48,6 +48,7 @@ type Config struct {
 	Job_Token          string
 	JWT_Secret         string
 	Crypto_Key         string
+	Max_Concurrency    int
 }
 
 var AppConfig *Config
@@ -75,6 +76,7 @@ func init() {
 		Job_Token:          getEnv("JOB_TOKEN", "6c9e5318-6e7b-452d-9e22-9f35a755bcbd"),
 		JWT_Secret:         getEnv("JWT_SECRET", "secret_key"),
 		Crypto_Key:         getEnv("CRYPTO_KEY", "HGD86teHeCb3Gl7Q"),
+		Max_Concurrency:    getEnvAsInt("WORKFLOW_MAX_CONCURRENCY", 4),
 	}
 }
 
@@ -123,6 +125,9 @@ func (db *DatabaseConnection) MigrateAndSeedDatabase() error {
 		&Workflow{},
 		&ReplayData{},
 		&Feature{},
+		&Run{},
+		&RunCheckpoint{},
+		&Approval{},
 	}
 
 	for _, model := range models {
@@ -0,0 +1,20 @@
+package DB
+
+import (
+	"context"
+	"time"
+)
+
+// AcquireLease claims key for owner until ttl passes and reports whether the
+// key was free. Leases mark work that a live server is responsible for.
+func AcquireLease(ctx context.Context, key string, owner string, ttl time.Duration) (bool, error) {
+	return RedisClient.SetNX(ctx, key, owner, ttl).Result()
+}
+
+func RenewLease(ctx context.Context, key string, ttl time.Duration) error {
+	return RedisClient.Expire(ctx, key, ttl).Err()
+}
+
+func ReleaseLease(ctx context.Context, key string) error {
+	return RedisClient.Del(ctx, key).Err()
+}
@@ -0,0 +1,36 @@
+package DB
+
+import (
+	"context"
+	"fmt"
+)
+
+func PublishMessage(ctx context.Context, channel string, message string) error {
+	if err := RedisClient.Publish(ctx, channel, message).Err(); err != nil {
+		return fmt.Errorf("failed to publish to channel %s: %w", channel, err)
+	}
+	return nil
+}
+
+// Subscribe returns the payloads published to channel until ctx is done.
+func Subscribe(ctx context.Context, channel string) <-chan string {
+	pubsub := RedisClient.Subscribe(ctx, channel)
+	messages := make(chan string)
+	go func() {
+		defer close(messages)
+		defer pubsub.Close()
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				messages <- msg.Payload
+			}
+		}
+	}()
+	return messages
+}
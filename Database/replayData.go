@@ -22,6 +22,8 @@ type ReplayData struct {
 	ProcessSequence int    `gorm:"omitempty"`
 	Data            JSONB  `gorm:"type:jsonb"`
 	Variables       JSONB  `gorm:"type:jsonb"`
+	TypedVariables  JSONB  `gorm:"type:jsonb"` // Variables keeping their kinds, see Server/value
+	Scoped          bool   // Written from a parallel branch, foreach item or subprocess, not the run's own variables
 	Status          int32  `gorm:"omitempty"`
 	Message         string `gorm:"omitempty"`
 	CreatedAt       int64  `gorm:"omitempty"`
@@ -104,7 +106,10 @@ func (db *DatabaseConnection) GetWorkflowHistory() ([]ReplayData, error) {
 		}
 	}
 
-	result := db.conn.Select("process_id, workflow_id, min(created_at) as created_at, MAX(CASE WHEN status = 2 THEN 2 WHEN status = 1 THEN 1 ELSE 0 END) as status").Group("process_id, workflow_id").Find(&replayData)
+	// Prefer the latest run-level record (empty node_id), since a parked run
+	// records WAITING before it finishes, otherwise fall back to the worst
+	// node status seen so far.
+	result := db.conn.Select("process_id, workflow_id, min(created_at) as created_at, COALESCE((ARRAY_AGG(status ORDER BY process_sequence DESC) FILTER (WHERE node_id = ''))[1], MAX(CASE WHEN status = 2 THEN 2 WHEN status = 1 THEN 1 ELSE 0 END)) as status").Group("process_id, workflow_id").Find(&replayData)
 
 	if result.Error != nil {
 		return nil, result.Error
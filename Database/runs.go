@@ -0,0 +1,158 @@
+package DB
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Run statuses mirror proto.NodeStatus so run rows and ReplayData agree.
+const (
+	RunStatusRunning   int32 = 0
+	RunStatusCompleted int32 = 1
+	RunStatusFailed    int32 = 2
+	RunStatusCancelled int32 = 4
+	RunStatusWaiting   int32 = 5
+)
+
+// Run is the durable record of an execution. Workflow holds the definition
+// the run started with so recovery does not pick up later edits. Runs resumed
+// from another run keep its id in ParentID and start at StartNodeID with
+// Variables instead of at the trigger. A WAITING run is parked until WakeAt,
+// or until something else wakes it when WakeAt is zero.
+type Run struct {
+	ID          string `gorm:"primaryKey"`
+	WorkflowID  string
+	ParentID    string `gorm:"index"`
+	Workflow    JSONB  `gorm:"type:jsonb"`
+	StartNodeID string
+	StartedBy   string // id of the user who started the run, empty for triggers
+	Variables   JSONB  `gorm:"type:jsonb"`
+	Status      int32  `gorm:"index"`
+	WakeAt      int64  `gorm:"index"`
+	CreatedAt   int64
+	UpdatedAt   int64
+}
+
+// RunCheckpoint is written after every node a run completes. Occurrence counts
+// the executions of NodeID within the run so loop iterations stay distinct.
+// Variables is empty when the node left the variables unchanged.
+type RunCheckpoint struct {
+	ID           string `gorm:"primaryKey"`
+	ProcessID    string `gorm:"index"`
+	NodeID       string
+	Occurrence   int
+	SourceHandle string
+	Variables    JSONB `gorm:"type:jsonb"`
+	Step         int32
+	CreatedAt    int64
+}
+
+func (db *DatabaseConnection) CreateRun(run *Run) error {
+	if run == nil {
+		return fmt.Errorf("run is Empty")
+	}
+	run.CreatedAt = time.Now().UTC().Unix()
+	run.UpdatedAt = run.CreatedAt
+	if err := db.conn.Create(run).Error; err != nil {
+		return err
+	}
+	log.Printf("Run %s added to DB", run.ID)
+	return nil
+}
+
+func (db *DatabaseConnection) UpdateRunStatus(id string, status int32) error {
+	result := db.conn.Model(&Run{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now().UTC().Unix(),
+	})
+	return result.Error
+}
+
+func (db *DatabaseConnection) GetRun(id string) (Run, error) {
+	var run Run
+	if err := db.conn.First(&run, "id = ?", id).Error; err != nil {
+		return Run{}, err
+	}
+	return run, nil
+}
+
+// GetUnfinishedRuns returns the RUNNING runs last updated before updatedBefore,
+// a unix timestamp.
+func (db *DatabaseConnection) GetUnfinishedRuns(updatedBefore int64) ([]Run, error) {
+	var runs []Run
+	if err := db.conn.Where("status = ? AND updated_at < ?", RunStatusRunning, updatedBefore).Order("created_at ASC").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// ParkRun marks a run WAITING until wakeAt, a unix timestamp.
+func (db *DatabaseConnection) ParkRun(id string, wakeAt int64) error {
+	result := db.conn.Model(&Run{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     RunStatusWaiting,
+		"wake_at":    wakeAt,
+		"updated_at": time.Now().UTC().Unix(),
+	})
+	return result.Error
+}
+
+// GetDueRuns returns the WAITING runs whose WakeAt has passed or whose
+// approval has been decided.
+func (db *DatabaseConnection) GetDueRuns(now int64) ([]Run, error) {
+	var runs []Run
+	decided := db.conn.Model(&Approval{}).Select("1").Where("approvals.process_id = runs.id AND approvals.decision <> ? AND approvals.consumed = ?", ApprovalPending, false)
+	if err := db.conn.Where("status = ? AND ((wake_at > 0 AND wake_at <= ?) OR EXISTS (?))", RunStatusWaiting, now, decided).Order("wake_at ASC").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// ClaimRun moves a WAITING run back to RUNNING and reports whether this call
+// was the one that woke it.
+func (db *DatabaseConnection) ClaimRun(id string) (bool, error) {
+	return db.setStatusIfWaiting(id, RunStatusRunning)
+}
+
+// CancelParkedRun cancels a run that is WAITING and reports whether it was.
+func (db *DatabaseConnection) CancelParkedRun(id string) (bool, error) {
+	return db.setStatusIfWaiting(id, RunStatusCancelled)
+}
+
+func (db *DatabaseConnection) setStatusIfWaiting(id string, status int32) (bool, error) {
+	result := db.conn.Model(&Run{}).Where("id = ? AND status = ?", id, RunStatusWaiting).Updates(map[string]interface{}{
+		"status":     status,
+		"wake_at":    0,
+		"updated_at": time.Now().UTC().Unix(),
+	})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (db *DatabaseConnection) SaveCheckpoint(checkpoint *RunCheckpoint) error {
+	if checkpoint == nil {
+		return fmt.Errorf("checkpoint is Empty")
+	}
+	checkpoint.CreatedAt = time.Now().UTC().Unix()
+	if checkpoint.ID == "" {
+		checkpoint.ID = uuid.NewString()
+	}
+	return db.conn.Create(checkpoint).Error
+}
+
+func (db *DatabaseConnection) GetCheckpoints(processID string) ([]RunCheckpoint, error) {
+	var checkpoints []RunCheckpoint
+	if err := db.conn.Where("process_id = ?", processID).Order("step ASC").Find(&checkpoints).Error; err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
+
+// DeleteCheckpoints drops the journal of a finished run.
+func (db *DatabaseConnection) DeleteCheckpoints(processID string) error {
+	return db.conn.Where("process_id = ?", processID).Delete(&RunCheckpoint{}).Error
+}
@@ -139,6 +139,8 @@ func (db *DatabaseConnection) GetWorkflow(id string) (*pb.Workflow, error) {
 		Description: wf.Description,
 		Nodes:       nodes,
 		Edges:       edges,
+		CreatedBy:   wf.CreatedBy,
+		UpdatedBy:   wf.UpdatedBy,
 		CreatedAt:   time.Unix(wf.CreatedAt, 0).Format("Jan 02, 2006"),
 		UpdatedAt:   time.Unix(wf.UpdatedAt, 0).Format("jan 02, 2006"),
 		Tags:        wf.Tags,
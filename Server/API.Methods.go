@@ -13,7 +13,11 @@ import (
 	wf "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
 	DB "github.com/raenardcruz/floowsynk/Database"
 	"github.com/raenardcruz/floowsynk/Server/crypto"
+	"github.com/raenardcruz/floowsynk/Server/value"
+	"github.com/raenardcruz/floowsynk/Server/workflow"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const UserRoleService = DB.UserRoleService
@@ -134,12 +138,18 @@ func ListWorkflows(offset int32, limit int32) (wl *wf.WorkflowList, err error) {
 	return wl, nil
 }
 func UpdateWorkflow(workflow *wf.Workflow) (w *wf.Workflow, err error) {
+	if err := checkWorkflow(workflow); err != nil {
+		return nil, err
+	}
 	if err := DBCon.UpdateWorkflow(workflow); err != nil {
 		return nil, err
 	}
 	return workflow, nil
 }
 func CreateWorkflow(workflow *wf.Workflow) (*wf.Workflow, error) {
+	if err := checkWorkflow(workflow); err != nil {
+		return nil, err
+	}
 	id, err := DBCon.CreateWorkflow(workflow)
 	if err != nil {
 		return nil, err
@@ -150,6 +160,149 @@ func CreateWorkflow(workflow *wf.Workflow) (*wf.Workflow, error) {
 func DeleteWorkflow(id string) error {
 	return DBCon.DeleteWorkflow(id)
 }
+
+// checkWorkflow rejects a workflow that fails validation before it is saved.
+func checkWorkflow(w *wf.Workflow) error {
+	return workflow.ValidationError(ValidateWorkflow(w).Issues)
+}
+
+// ValidateWorkflow checks the workflow graph, and that its subprocess nodes do
+// not end up calling a workflow that is already running further up the call
+// chain.
+func ValidateWorkflow(w *wf.Workflow) *wf.ValidationResult {
+	issues := workflow.Validate(w)
+	if cycle := workflow.SubprocessCycle(w, GetWorkflow); cycle != nil {
+		issues = append(issues, &wf.ValidationIssue{
+			Severity: workflow.SeverityError,
+			Message:  fmt.Sprintf("subprocess calls form a cycle: %s", strings.Join(cycle, " -> ")),
+		})
+	}
+	return &wf.ValidationResult{
+		Valid:  workflow.ValidationError(issues) == nil,
+		Issues: issues,
+	}
+}
+
+// CancelRun stops the run locally when this server owns it, otherwise it
+// broadcasts the request so the replica running it can cancel it.
+func CancelRun(ctx context.Context, runId string, user *ValidateResults) error {
+	if runId == "" {
+		return fmt.Errorf("run id is required")
+	}
+	workflowId, startedBy, err := runOwner(runId)
+	if err != nil {
+		return err
+	}
+	if !canCancel(workflowId, startedBy, user) {
+		return fmt.Errorf("user %s is not allowed to cancel run %s", user.username, runId)
+	}
+	if workflow.CancelRun(runId) {
+		return nil
+	}
+	if cancelled, err := workflow.CancelParkedRun(DBCon, producer, runId); err != nil || cancelled {
+		return err
+	}
+	return DB.PublishMessage(ctx, workflow.CANCEL_RUN_CHANNEL, runId)
+}
+
+// DebugControl applies a debugger command locally when this server runs the
+// debug run, otherwise it broadcasts it to the replica running it.
+func DebugControl(ctx context.Context, cmd *wf.DebugCommand, user *ValidateResults) error {
+	if cmd.ProcessId == "" {
+		return fmt.Errorf("run id is required")
+	}
+	found, err := workflow.DebugControl(cmd, user.id)
+	if err != nil || found {
+		return err
+	}
+	command, err := protojson.Marshal(cmd)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(workflow.DebugMessage{UserID: user.id, Command: command})
+	if err != nil {
+		return err
+	}
+	return DB.PublishMessage(ctx, workflow.DEBUG_RUN_CHANNEL, string(data))
+}
+
+// DecideStep records an approver's decision on the pending approval of a run
+// and wakes the run so it continues along the matching handle.
+func DecideStep(req *wf.StepDecisionRequest, user *ValidateResults, decision string) error {
+	if user.role == DB.UserRoleGuest || user.role == UserRoleService {
+		return fmt.Errorf("%s users cannot decide approvals", user.role)
+	}
+	approval, found, err := DBCon.GetOpenApproval(req.ProcessId, req.NodeId)
+	if err != nil {
+		return err
+	}
+	if !found || approval.Decision != DB.ApprovalPending {
+		return fmt.Errorf("no pending approval for node %s in run %s", req.NodeId, req.ProcessId)
+	}
+	if !canApprove(approval.Approvers, user) {
+		return fmt.Errorf("user %s is not allowed to decide this approval", user.username)
+	}
+	decided, err := DBCon.DecideApproval(approval.ID, decision, user.username, req.Comment)
+	if err != nil {
+		return err
+	}
+	if !decided {
+		return fmt.Errorf("approval for node %s in run %s was already decided", req.NodeId, req.ProcessId)
+	}
+	return workflow.WakeRun(DBCon, producer, req.ProcessId)
+}
+
+// runOwner returns the workflow of a run and the user who started it, looking
+// at the runs of this server, then the saved runs, then the run history. Only
+// local and saved runs record who started them.
+func runOwner(runId string) (workflowId, startedBy string, err error) {
+	if workflowId, startedBy, ok := workflow.ActiveRunOwner(runId); ok {
+		return workflowId, startedBy, nil
+	}
+	if run, err := DBCon.GetRun(runId); err == nil {
+		return run.WorkflowID, run.StartedBy, nil
+	}
+	history, err := DBCon.GetReplayDataGroupedByProcessID(runId)
+	if err != nil {
+		return "", "", err
+	}
+	if len(history) == 0 {
+		return "", "", fmt.Errorf("run %s not found", runId)
+	}
+	return history[0].WorkflowID, "", nil
+}
+
+// canCancel reports whether user may cancel a run: admins may cancel any run,
+// other users the runs they started and the runs of workflows they created.
+func canCancel(workflowId, startedBy string, user *ValidateResults) bool {
+	if user.role == DB.UserRoleAdmin {
+		return true
+	}
+	if user.id == "" {
+		return false
+	}
+	if startedBy == user.id {
+		return true
+	}
+	if workflowId == "" {
+		return false
+	}
+	w, err := DBCon.GetWorkflow(workflowId)
+	return err == nil && w.CreatedBy == user.id
+}
+
+func canApprove(approvers []string, user *ValidateResults) bool {
+	if len(approvers) == 0 {
+		return true
+	}
+	for _, approver := range approvers {
+		if approver == user.username || approver == user.id || approver == user.role {
+			return true
+		}
+	}
+	return false
+}
+
 func ListWorkflowHistoryImpl() (*wf.WorkflowHistoryList, error) {
 	history, err := DBCon.GetWorkflowHistory()
 	if err != nil {
@@ -193,13 +346,27 @@ func GetWorkflowHistoryImpl(Id string) (*wf.WorkflowHistoryResponse, error) {
 			log.Printf("Error unmarshalling variables: %v", err)
 			return nil, err
 		}
+		typed, err := workflow.RecordVariables(rd)
+		if err != nil {
+			log.Printf("Error unmarshalling typed variables: %v", err)
+			return nil, err
+		}
+		var typedVariables *structpb.Struct
+		if typed != nil {
+			if typedVariables, err = value.Struct(typed); err != nil {
+				log.Printf("Error converting typed variables: %v", err)
+				return nil, err
+			}
+		}
 
 		data = append(data, &wf.ReplayData{
-			NodeId:    rd.NodeID,
-			Data:      &nodeData,
-			Variables: variables,
-			Status:    wf.NodeStatus(rd.Status),
-			Message:   rd.Message,
+			NodeId:         rd.NodeID,
+			Data:           &nodeData,
+			Variables:      variables,
+			TypedVariables: typedVariables,
+			Status:         wf.NodeStatus(rd.Status),
+			Message:        rd.Message,
+			ProcessId:      rd.ProcessID,
 		})
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	lg "github.com/raenardcruz/floowsynk/CodeGen/go/login"
 	wf "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	"github.com/raenardcruz/floowsynk/Server/value"
 	"github.com/raenardcruz/floowsynk/Server/workflow"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -108,6 +109,18 @@ func (s *WorkflowServer) CreateWorkflow(ctx context.Context, req *wf.Workflow) (
 	return wl, nil
 }
 
+func (s *WorkflowServer) ValidateWorkflow(ctx context.Context, req *wf.Workflow) (*wf.ValidationResult, error) {
+	token, err := getTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validateResults := validateToken(token)
+	if validateResults.status != http.StatusOK {
+		return nil, fmt.Errorf(validateResults.message)
+	}
+	return ValidateWorkflow(req), nil
+}
+
 func (s *WorkflowServer) DeleteWorkflow(ctx context.Context, req *wf.Workflow) (*emptypb.Empty, error) {
 	token, err := getTokenFromContext(ctx)
 	if err != nil {
@@ -136,16 +149,20 @@ func (s *WorkflowServer) QuickRun(req *wf.Workflow, stream wf.WorkflowService_Qu
 	if validateResults.status != http.StatusOK {
 		return fmt.Errorf(validateResults.message)
 	}
+	if err := workflow.ValidationError(workflow.Validate(req)); err != nil {
+		return err
+	}
 	processor := workflow.WorkflowProcessor{
 		ID:               uuid.NewString(),
 		Stream:           stream,
 		Workflow:         req,
-		ProcessVariables: make(map[string]interface{}),
+		ProcessVariables: make(map[string]value.Value),
 		DBcon:            *DBCon,
 		Producer:         producer,
 		Step:             1,
+		StartedBy:        validateResults.id,
 	}
-	err = processor.StartWorkflow()
+	err = processor.StartWorkflow(ctx)
 	if err != nil {
 		return err
 	}
@@ -163,17 +180,154 @@ func (s *WorkflowServer) RunWorkflowId(req *wf.RunWorkflowIdRequest, stream wf.W
 		return fmt.Errorf(validateResults.message)
 	}
 	wf, err := GetWorkflow(req.Id)
+	if err != nil {
+		return err
+	}
 	processor := workflow.WorkflowProcessor{
-		Stream:           nil,
-		ProcessVariables: make(map[string]interface{}),
+		ID:               uuid.NewString(),
+		Stream:           stream,
+		ProcessVariables: make(map[string]value.Value),
 		DBcon:            *DBCon,
 		Workflow:         wf,
+		Producer:         producer,
 		Step:             1,
+		StartedBy:        validateResults.id,
+		Durable:          true,
 	}
-	processor.StartWorkflow()
+	// A durable run outlives the stream that started it; only CancelRun
+	// stops it.
+	processor.StartWorkflow(context.WithoutCancel(ctx))
 	return nil
 }
 
+func (s *WorkflowServer) DebugRun(req *wf.DebugRunRequest, stream wf.WorkflowService_DebugRunServer) error {
+	ctx := stream.Context()
+	token, err := getTokenFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	validateResults := validateToken(token)
+	if validateResults.status != http.StatusOK {
+		return fmt.Errorf(validateResults.message)
+	}
+	if err := workflow.ValidationError(workflow.Validate(req.Workflow)); err != nil {
+		return err
+	}
+	processor := workflow.WorkflowProcessor{
+		ID:               uuid.NewString(),
+		Stream:           stream,
+		Workflow:         req.Workflow,
+		ProcessVariables: make(map[string]value.Value),
+		DBcon:            *DBCon,
+		Producer:         producer,
+		Step:             1,
+		StartedBy:        validateResults.id,
+		Debug:            true,
+		Breakpoints:      req.Breakpoints,
+		PauseAtStart:     req.PauseAtStart,
+	}
+	return processor.StartWorkflow(ctx)
+}
+
+func (s *WorkflowServer) DebugControl(ctx context.Context, req *wf.DebugCommand) (*emptypb.Empty, error) {
+	token, err := getTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validateResults := validateToken(token)
+	if validateResults.status != http.StatusOK {
+		return nil, fmt.Errorf(validateResults.message)
+	}
+	if err := DebugControl(ctx, req, validateResults); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WorkflowServer) ResumeRun(req *wf.ResumeRunRequest, stream wf.WorkflowService_ResumeRunServer) error {
+	ctx := stream.Context()
+	token, err := getTokenFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	validateResults := validateToken(token)
+	if validateResults.status != http.StatusOK {
+		return fmt.Errorf(validateResults.message)
+	}
+	history, err := DBCon.GetReplayDataGroupedByProcessID(req.ProcessId)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		return fmt.Errorf("run %s not found", req.ProcessId)
+	}
+	variables, err := workflow.VariablesBeforeNode(history, req.FromNodeId)
+	if err != nil {
+		return err
+	}
+	// Resume against the workflow as the run saw it, not as edited since.
+	run, err := DBCon.GetRun(req.ProcessId)
+	if err != nil {
+		return fmt.Errorf("run %s has no saved workflow to resume: %v", req.ProcessId, err)
+	}
+	wf, err := workflow.RunSnapshot(run)
+	if err != nil {
+		return err
+	}
+	processor := workflow.WorkflowProcessor{
+		ID:               uuid.NewString(),
+		Stream:           stream,
+		ProcessVariables: make(map[string]value.Value),
+		DBcon:            *DBCon,
+		Workflow:         wf,
+		Producer:         producer,
+		Step:             1,
+		StartedBy:        validateResults.id,
+		Durable:          true,
+		ParentID:         req.ProcessId,
+	}
+	// Durable like RunWorkflowId, so it outlives the stream as well.
+	return processor.ContinueWorkflow(context.WithoutCancel(ctx), req.FromNodeId, variables)
+}
+
+func (s *WorkflowServer) ApproveStep(ctx context.Context, req *wf.StepDecisionRequest) (*emptypb.Empty, error) {
+	return decideStep(ctx, req, workflow.APPROVED)
+}
+
+func (s *WorkflowServer) RejectStep(ctx context.Context, req *wf.StepDecisionRequest) (*emptypb.Empty, error) {
+	return decideStep(ctx, req, workflow.REJECTED)
+}
+
+func decideStep(ctx context.Context, req *wf.StepDecisionRequest, decision string) (*emptypb.Empty, error) {
+	token, err := getTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validateResults := validateToken(token)
+	if validateResults.status != http.StatusOK {
+		return nil, fmt.Errorf(validateResults.message)
+	}
+	if err := DecideStep(req, validateResults, decision); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WorkflowServer) CancelRun(ctx context.Context, req *wf.CancelRunRequest) (*emptypb.Empty, error) {
+	token, err := getTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validateResults := validateToken(token)
+	if validateResults.status != http.StatusOK {
+		return nil, fmt.Errorf(validateResults.message)
+	}
+	if err := CancelRun(ctx, req.RunId, validateResults); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *WorkflowServer) ListWorkflowHistory(ctx context.Context, in *emptypb.Empty) (*wf.WorkflowHistoryList, error) {
 	token, err := getTokenFromContext(ctx)
 	if err != nil {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"net"
@@ -15,9 +16,11 @@ import (
 	wf "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
 	db "github.com/raenardcruz/floowsynk/Database"
 	"github.com/raenardcruz/floowsynk/Server/crypto"
+	"github.com/raenardcruz/floowsynk/Server/value"
 	"github.com/raenardcruz/floowsynk/Server/workflow"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // LoginServer handles login-related gRPC services
@@ -57,6 +60,10 @@ func main() {
 
 	startRESTServer()
 	setupPlainGRPCServer()
+	startCancelListener()
+	startDebugListener()
+	workflow.RecoverRuns(context.Background(), DBCon, producer)
+	workflow.StartScheduler(context.Background(), DBCon, producer)
 
 	grpcPort := ":" + db.AppConfig.Server_GRPC_Port
 	log.Println("gRPC Web server started at", grpcPort)
@@ -135,6 +142,40 @@ func setupPlainGRPCServer() {
 	}()
 }
 
+// startCancelListener cancels local runs when another replica receives a
+// CancelRun request for them.
+func startCancelListener() {
+	go func() {
+		for runId := range db.Subscribe(context.Background(), workflow.CANCEL_RUN_CHANNEL) {
+			if workflow.CancelRun(runId) {
+				log.Printf("Cancelled run %s", runId)
+			}
+		}
+	}()
+}
+
+// startDebugListener applies debugger commands for local debug runs that
+// another replica received.
+func startDebugListener() {
+	go func() {
+		for message := range db.Subscribe(context.Background(), workflow.DEBUG_RUN_CHANNEL) {
+			var forwarded workflow.DebugMessage
+			if err := json.Unmarshal([]byte(message), &forwarded); err != nil {
+				log.Printf("Error decoding debug command: %v", err)
+				continue
+			}
+			var cmd wf.DebugCommand
+			if err := protojson.Unmarshal(forwarded.Command, &cmd); err != nil {
+				log.Printf("Error decoding debug command: %v", err)
+				continue
+			}
+			if _, err := workflow.DebugControl(&cmd, forwarded.UserID); err != nil {
+				log.Printf("Error applying debug command to run %s: %v", cmd.ProcessId, err)
+			}
+		}
+	}()
+}
+
 func runWebhookHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Only POST method is allowed", http.StatusMethodNotAllowed)
@@ -165,11 +206,14 @@ func runWebhookHandler(w http.ResponseWriter, r *http.Request) {
 		DBcon:            *DBCon,
 		Workflow:         workflowObj,
 		Stream:           nil,
-		ProcessVariables: make(map[string]interface{}),
+		ProcessVariables: make(map[string]value.Value),
 		Producer:         producer,
+		Durable:          true,
 	}
 
-	if err := wp.StartWorkflow(); err != nil {
+	// A durable run outlives the request that started it; only CancelRun
+	// stops it.
+	if err := wp.StartWorkflow(context.WithoutCancel(r.Context())); err != nil {
 		http.Error(w, "Failed to start workflow: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -0,0 +1,330 @@
+package matheval
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ArgType is the type a Function expects an argument to have. Expressions
+// evaluate to float64, string, bool or, for lists, []interface{}.
+type ArgType int
+
+const (
+	AnyArg ArgType = iota
+	NumberArg
+	StringArg
+	BoolArg
+	ListArg
+)
+
+func (t ArgType) String() string {
+	switch t {
+	case NumberArg:
+		return "a number"
+	case StringArg:
+		return "a string"
+	case BoolArg:
+		return "a boolean"
+	case ListArg:
+		return "a list"
+	default:
+		return "any value"
+	}
+}
+
+func (t ArgType) accepts(arg interface{}) bool {
+	switch t {
+	case NumberArg:
+		_, ok := arg.(float64)
+		return ok
+	case StringArg:
+		_, ok := arg.(string)
+		return ok
+	case BoolArg:
+		_, ok := arg.(bool)
+		return ok
+	case ListArg:
+		_, ok := arg.([]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+// Function is a function expressions can call. Its arguments are checked
+// against Args before Call runs.
+type Function struct {
+	Args     []ArgType // the type of each argument
+	Optional int       // how many of the last Args may be left out
+	Variadic bool      // whether the last of Args may repeat
+	Call     func(args []interface{}) (interface{}, error)
+}
+
+// Functions is a set of functions expressions compiled with CompileWith can
+// call on top of the built-in ones. It is safe for concurrent use.
+type Functions struct {
+	mu     sync.RWMutex
+	byName map[string]Function
+}
+
+// builtins are the functions every expression can call.
+var builtins = make(map[string]Function)
+
+// NewFunctions returns an empty set of functions.
+func NewFunctions() *Functions {
+	return &Functions{byName: make(map[string]Function)}
+}
+
+// Register makes fn callable as name from expressions compiled with f. A name
+// already taken by a built-in function or by another function of f is
+// rejected.
+func (f *Functions) Register(name string, fn Function) error {
+	if err := fn.check(name); err != nil {
+		return err
+	}
+	if _, ok := builtins[name]; ok {
+		return fmt.Errorf("function %s is a built-in function", name)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.byName[name]; ok {
+		return fmt.Errorf("function %s is already registered", name)
+	}
+	f.byName[name] = fn
+	return nil
+}
+
+func (f *Functions) lookup(name string) (Function, bool) {
+	if f == nil {
+		return Function{}, false
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	fn, ok := f.byName[name]
+	return fn, ok
+}
+
+// check reports whether fn can be registered as name.
+func (fn Function) check(name string) error {
+	if fn.Call == nil {
+		return fmt.Errorf("function %s has no Call", name)
+	}
+	if fn.Optional > len(fn.Args) || (fn.Variadic && len(fn.Args) == 0) {
+		return fmt.Errorf("function %s has an invalid signature", name)
+	}
+	return nil
+}
+
+func mustRegister(name string, fn Function) {
+	if err := fn.check(name); err != nil {
+		panic(err)
+	}
+	if _, ok := builtins[name]; ok {
+		panic(fmt.Sprintf("function %s is already registered", name))
+	}
+	builtins[name] = fn
+}
+
+// lookupFunction returns the built-in function name, or else the one functions
+// has under that name.
+func lookupFunction(functions *Functions, name string) (Function, bool) {
+	if fn, ok := builtins[name]; ok {
+		return fn, true
+	}
+	return functions.lookup(name)
+}
+
+// call checks args against the signature of fn and calls it.
+func (fn Function) call(name string, args []interface{}) (interface{}, error) {
+	required := len(fn.Args) - fn.Optional
+	switch {
+	case fn.Variadic && len(args) < required:
+		return nil, fmt.Errorf("%s expects at least %d arguments, got %d", name, required, len(args))
+	case !fn.Variadic && (len(args) < required || len(args) > len(fn.Args)):
+		if fn.Optional == 0 {
+			return nil, fmt.Errorf("%s expects %d arguments, got %d", name, len(fn.Args), len(args))
+		}
+		return nil, fmt.Errorf("%s expects %d to %d arguments, got %d", name, required, len(fn.Args), len(args))
+	}
+	for i, arg := range args {
+		argType := fn.Args[min(i, len(fn.Args)-1)]
+		if !argType.accepts(arg) {
+			return nil, fmt.Errorf("argument %d of %s must be %s", i+1, name, argType)
+		}
+	}
+	return fn.Call(args)
+}
+
+func numeric(f func(float64) float64) Function {
+	return Function{
+		Args: []ArgType{NumberArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			return f(args[0].(float64)), nil
+		},
+	}
+}
+
+func stringPredicate(f func(s, substr string) bool) Function {
+	return Function{
+		Args: []ArgType{StringArg, StringArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			return f(args[0].(string), args[1].(string)), nil
+		},
+	}
+}
+
+func init() {
+	mustRegister(listFunc, Function{
+		Args:     []ArgType{AnyArg},
+		Optional: 1,
+		Variadic: true,
+		Call: func(args []interface{}) (interface{}, error) {
+			return append([]interface{}{}, args...), nil
+		},
+	})
+	mustRegister("min", Function{
+		Args:     []ArgType{NumberArg},
+		Variadic: true,
+		Call: func(args []interface{}) (interface{}, error) {
+			result := args[0].(float64)
+			for _, arg := range args[1:] {
+				result = math.Min(result, arg.(float64))
+			}
+			return result, nil
+		},
+	})
+	mustRegister("max", Function{
+		Args:     []ArgType{NumberArg},
+		Variadic: true,
+		Call: func(args []interface{}) (interface{}, error) {
+			result := args[0].(float64)
+			for _, arg := range args[1:] {
+				result = math.Max(result, arg.(float64))
+			}
+			return result, nil
+		},
+	})
+	mustRegister("clamp", Function{
+		Args: []ArgType{NumberArg, NumberArg, NumberArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			x, lo, hi := args[0].(float64), args[1].(float64), args[2].(float64)
+			if lo > hi {
+				return nil, fmt.Errorf("clamp lower bound %v is above upper bound %v", lo, hi)
+			}
+			return math.Min(math.Max(x, lo), hi), nil
+		},
+	})
+	mustRegister("abs", numeric(math.Abs))
+	mustRegister("floor", numeric(math.Floor))
+	mustRegister("ceil", numeric(math.Ceil))
+	mustRegister("round", Function{
+		Args:     []ArgType{NumberArg, NumberArg},
+		Optional: 1,
+		Call: func(args []interface{}) (interface{}, error) {
+			x := args[0].(float64)
+			if len(args) == 1 {
+				return math.Round(x), nil
+			}
+			places := args[1].(float64)
+			if places != math.Trunc(places) {
+				return nil, fmt.Errorf("round places must be a whole number")
+			}
+			scale := math.Pow(10, places)
+			return math.Round(x*scale) / scale, nil
+		},
+	})
+	mustRegister("sqrt", Function{
+		Args: []ArgType{NumberArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			x := args[0].(float64)
+			if x < 0 {
+				return nil, fmt.Errorf("square root of negative number")
+			}
+			return math.Sqrt(x), nil
+		},
+	})
+	mustRegister("pow", Function{
+		Args: []ArgType{NumberArg, NumberArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			return math.Pow(args[0].(float64), args[1].(float64)), nil
+		},
+	})
+	mustRegister("log", Function{
+		Args: []ArgType{NumberArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			x := args[0].(float64)
+			if x <= 0 {
+				return nil, fmt.Errorf("logarithm of non-positive number")
+			}
+			return math.Log(x), nil
+		},
+	})
+	mustRegister("len", Function{
+		Args: []ArgType{AnyArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			switch arg := args[0].(type) {
+			case string:
+				return float64(len([]rune(arg))), nil
+			case []interface{}:
+				return float64(len(arg)), nil
+			}
+			return nil, fmt.Errorf("argument 1 of len must be a string or a list")
+		},
+	})
+	mustRegister("lower", Function{
+		Args: []ArgType{StringArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			return strings.ToLower(args[0].(string)), nil
+		},
+	})
+	mustRegister("upper", Function{
+		Args: []ArgType{StringArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			return strings.ToUpper(args[0].(string)), nil
+		},
+	})
+	mustRegister("contains", Function{
+		Args: []ArgType{AnyArg, AnyArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			return contains(args[0], args[1])
+		},
+	})
+	mustRegister("number", Function{
+		Args: []ArgType{AnyArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			switch arg := args[0].(type) {
+			case float64:
+				return arg, nil
+			case string:
+				n, err := strconv.ParseFloat(strings.TrimSpace(arg), 64)
+				if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+					return nil, fmt.Errorf("%q is not a number", arg)
+				}
+				return n, nil
+			}
+			return nil, fmt.Errorf("argument 1 of number must be a number or a string")
+		},
+	})
+	mustRegister("string", Function{
+		Args: []ArgType{AnyArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			switch arg := args[0].(type) {
+			case string:
+				return arg, nil
+			case float64:
+				if arg == math.Trunc(arg) && math.Abs(arg) < 1e21 {
+					return strconv.FormatFloat(arg, 'f', -1, 64), nil
+				}
+				return strconv.FormatFloat(arg, 'g', -1, 64), nil
+			case bool:
+				return strconv.FormatBool(arg), nil
+			}
+			return nil, fmt.Errorf("argument 1 of string must be a number, a string or a boolean")
+		},
+	})
+	mustRegister("startsWith", stringPredicate(strings.HasPrefix))
+	mustRegister("endsWith", stringPredicate(strings.HasSuffix))
+}
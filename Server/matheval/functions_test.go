@@ -0,0 +1,144 @@
+package matheval
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinFunctions(t *testing.T) {
+	variables := map[string]interface{}{
+		"name":  "Ünïcode",
+		"items": []interface{}{1.0, "two", 3.0},
+		"zip":   "01234",
+	}
+	tests := []struct {
+		expression string
+		want       interface{}
+	}{
+		{`min(3, 1, 2)`, 1.0},
+		{`min(5)`, 5.0},
+		{`max(3, 1, 2)`, 3.0},
+		{`clamp(15, 0, 10)`, 10.0},
+		{`clamp(-5, 0, 10)`, 0.0},
+		{`clamp(5, 0, 10)`, 5.0},
+		{`abs(-2.5)`, 2.5},
+		{`floor(2.7)`, 2.0},
+		{`ceil(2.1)`, 3.0},
+		{`round(2.5)`, 3.0},
+		{`round(3.14159, 2)`, 3.14},
+		{`round(1234, -2)`, 1200.0},
+		{`sqrt(16)`, 4.0},
+		{`pow(2, 10)`, 1024.0},
+		{`log(1)`, 0.0},
+		{`len("abc")`, 3.0},
+		{`len(name)`, 7.0},
+		{`len(items)`, 3.0},
+		{`len([])`, 0.0},
+		{`lower("MiXeD")`, "mixed"},
+		{`upper("MiXeD")`, "MIXED"},
+		{`contains(items, "two")`, true},
+		{`contains(items, 2)`, false},
+		{`contains("haystack", "st")`, true},
+		{`number(zip)`, 1234.0},
+		{`number(" 2.5 ")`, 2.5},
+		{`number(7)`, 7.0},
+		{`string(250)`, "250"},
+		{`string(0.5)`, "0.5"},
+		{`string(1e21)`, "1e+21"},
+		{`string(true)`, "true"},
+		{`string(zip)`, "01234"},
+		{`startsWith(zip, "01")`, true},
+		{`startsWith(zip, "1")`, false},
+		{`endsWith("report.pdf", ".pdf")`, true},
+		{`[1, "a", true]`, []interface{}{1.0, "a", true}},
+		{`max(len(items), 2) * 2`, 6.0},
+	}
+	for _, test := range tests {
+		x, err := Compile(test.expression)
+		if err != nil {
+			t.Errorf("%s: %v", test.expression, err)
+			continue
+		}
+		got, err := x.Value(variables)
+		if err != nil {
+			t.Errorf("%s: %v", test.expression, err)
+		} else if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s = %#v, want %#v", test.expression, got, test.want)
+		}
+	}
+
+	for _, expression := range []string{
+		`min()`,
+		`abs()`,
+		`abs(1, 2)`,
+		`abs("1")`,
+		`clamp(1, 10, 0)`,
+		`round(1.5, 0.5)`,
+		`sqrt(-1)`,
+		`log(0)`,
+		`len(5)`,
+		`lower(5)`,
+		`startsWith("a")`,
+		`number("abc")`,
+		`number("NaN")`,
+		`number(true)`,
+		`string(items)`,
+		`unknown(1)`,
+	} {
+		x, err := Compile(expression)
+		if err != nil {
+			continue
+		}
+		if got, err := x.Value(variables); err == nil {
+			t.Errorf("%s = %#v, want an error", expression, got)
+		}
+	}
+	if got, err := EvaluateNumeric("pow(2, 0.5)"); err != nil || math.Abs(got-math.Sqrt2) > 1e-12 {
+		t.Errorf("pow(2, 0.5) = %v, %v", got, err)
+	}
+}
+
+func TestFunctionsAreScopedToTheirSet(t *testing.T) {
+	double := Function{
+		Args: []ArgType{NumberArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			return args[0].(float64) * 2, nil
+		},
+	}
+	functions := NewFunctions()
+	if err := functions.Register("double", double); err != nil {
+		t.Fatal(err)
+	}
+	if err := functions.Register("double", double); err == nil {
+		t.Error("registering double twice succeeded")
+	}
+	if err := functions.Register("max", double); err == nil {
+		t.Error("registering over the built-in max succeeded")
+	}
+	if err := functions.Register("broken", Function{Args: []ArgType{NumberArg}}); err == nil {
+		t.Error("registering a function without Call succeeded")
+	}
+
+	x, err := CompileWith("double(x) + max(1, 2)", functions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := x.Numeric(map[string]interface{}{"x": 4.0}); err != nil || got != 10 {
+		t.Errorf("double(x) + max(1, 2) = %v, %v, want 10", got, err)
+	}
+	if _, err := x.Numeric(map[string]interface{}{"x": "4"}); err == nil {
+		t.Error("double accepted a string")
+	}
+
+	for _, other := range []*Functions{nil, NewFunctions()} {
+		x, err := CompileWith("double(2)", other)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := x.Numeric(nil); err == nil || !strings.Contains(err.Error(), "double") {
+			t.Errorf("double called without being in the set: %v", err)
+		}
+	}
+}
@@ -1,116 +1,268 @@
 package matheval
 
 import (
+	"encoding/json"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/scanner"
 	"go/token"
 	"math"
 	"strconv"
+	"strings"
 )
 
+// listFunc is the function a list literal [a, b] is rewritten to call.
+const listFunc = "list"
+
+// Expression is a parsed expression. It can be evaluated any number of times,
+// concurrently, against different variables.
+type Expression struct {
+	fset       *token.FileSet
+	membership map[int]bool // offsets of the `==` that stand for `in`
+	root       ast.Expr
+	functions  *Functions // callable on top of the built-in functions
+}
+
+// evaluator evaluates an Expression against one set of variables.
+type evaluator struct {
+	*Expression
+	variables map[string]interface{}
+}
+
 // EvaluateNumeric evaluates a mathematical expression and returns a numeric result
 func EvaluateNumeric(expression string) (float64, error) {
-	expr, err := parser.ParseExpr(expression)
+	return EvaluateNumericIn(expression, nil)
+}
+
+// EvaluateNumericIn is EvaluateNumeric with identifiers and paths such as
+// order.total or items[0].price resolved against variables, whose values are
+// float64, json.Number, string, bool, nil, []interface{} or
+// map[string]interface{}.
+func EvaluateNumericIn(expression string, variables map[string]interface{}) (float64, error) {
+	x, err := Compile(expression)
 	if err != nil {
 		return 0, err
 	}
+	return x.Numeric(variables)
+}
 
-	if isComparisonOperator(getBinaryExprOp(expr)) {
-		return 0, fmt.Errorf("boolean expression provided to EvaluateNumeric")
+// EvaluateBoolean evaluates a boolean expression and returns true/false
+func EvaluateBoolean(expression string) (bool, error) {
+	return EvaluateBooleanIn(expression, nil)
+}
+
+// EvaluateBooleanIn is EvaluateBoolean with identifiers resolved against
+// variables, as in EvaluateNumericIn.
+func EvaluateBooleanIn(expression string, variables map[string]interface{}) (bool, error) {
+	x, err := Compile(expression)
+	if err != nil {
+		return false, err
 	}
+	return x.Boolean(variables)
+}
 
-	result, err := eval(expr)
+// Numeric evaluates x against variables and returns a numeric result.
+func (x *Expression) Numeric(variables map[string]interface{}) (float64, error) {
+	e := &evaluator{Expression: x, variables: variables}
+	result, err := e.eval(x.root)
 	if err != nil {
 		return 0, err
 	}
 
-	if numResult, ok := result.(float64); ok {
-		return numResult, nil
+	switch result := result.(type) {
+	case float64:
+		return result, nil
+	case bool:
+		return 0, fmt.Errorf("boolean expression provided to EvaluateNumeric")
 	}
 	return 0, fmt.Errorf("non-numeric result")
 }
 
-// EvaluateBoolean evaluates a boolean expression and returns true/false
-func EvaluateBoolean(expression string) (bool, error) {
-	expr, err := parser.ParseExpr(expression)
+// Boolean evaluates x against variables and returns true/false.
+func (x *Expression) Boolean(variables map[string]interface{}) (bool, error) {
+	e := &evaluator{Expression: x, variables: variables}
+	result, err := e.eval(x.root)
 	if err != nil {
 		return false, err
 	}
 
-	if !isComparisonOperator(getBinaryExprOp(expr)) {
+	switch result := result.(type) {
+	case bool:
+		return result, nil
+	case float64:
 		return false, fmt.Errorf("numeric expression provided to EvaluateBoolean")
 	}
-
-	result, err := eval(expr)
-	if err != nil {
-		return false, err
-	}
-	if boolResult, ok := result.(float64); ok {
-		return boolResult == 1, nil
-	}
 	return false, fmt.Errorf("non-boolean result")
 }
 
-func getBinaryExprOp(expr ast.Expr) token.Token {
-	if bExpr, ok := expr.(*ast.BinaryExpr); ok {
-		return bExpr.Op
-	}
-	return token.ILLEGAL
+// Value evaluates x against variables and returns its result, a float64,
+// string, bool or []interface{}.
+func (x *Expression) Value(variables map[string]interface{}) (interface{}, error) {
+	e := &evaluator{Expression: x, variables: variables}
+	return e.eval(x.root)
 }
 
-func evaluate(expression string) (float64, error) {
-	expr, err := parser.ParseExpr(expression)
-	if err != nil {
-		return 0, err
+// Compile parses expression. Go has no `in` operator or list literals, so it
+// first rewrites `x in y` into `x == y`, which has the same precedence and is
+// told apart by position, and a list literal [a, b] into list(a, b).
+func Compile(expression string) (*Expression, error) {
+	return CompileWith(expression, nil)
+}
+
+// CompileWith is Compile for an expression that may also call functions.
+func CompileWith(expression string, functions *Functions) (*Expression, error) {
+	var scanErr error
+	var s scanner.Scanner
+	src := []byte(expression)
+	s.Init(token.NewFileSet().AddFile("", -1, len(src)), src, func(pos token.Position, msg string) {
+		if scanErr == nil {
+			scanErr = fmt.Errorf("%s: %s", pos, msg)
+		}
+	}, 0)
+
+	x := &Expression{fset: token.NewFileSet(), membership: make(map[int]bool), functions: functions}
+	var rewritten strings.Builder
+	var brackets []bool // whether each open bracket starts a list literal
+	prev := token.ILLEGAL
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+		if rewritten.Len() > 0 {
+			rewritten.WriteByte(' ')
+		}
+		text := lit
+		if text == "" {
+			text = tok.String()
+		}
+		switch {
+		case tok == token.IDENT && lit == "in":
+			x.membership[rewritten.Len()] = true
+			tok, text = token.EQL, token.EQL.String()
+		case tok == token.LBRACK:
+			list := !endsOperand(prev)
+			brackets = append(brackets, list)
+			if list {
+				text = listFunc + "("
+			}
+		case tok == token.RBRACK && len(brackets) > 0:
+			if brackets[len(brackets)-1] {
+				text = ")"
+			}
+			brackets = brackets[:len(brackets)-1]
+		}
+		rewritten.WriteString(text)
+		prev = tok
+	}
+	if scanErr != nil {
+		return nil, scanErr
 	}
 
-	result, err := eval(expr)
+	root, err := parser.ParseExprFrom(x.fset, "", rewritten.String(), 0)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
+	x.root = root
+	return x, nil
+}
 
-	if numResult, ok := result.(float64); ok {
-		return numResult, nil
+// endsOperand reports whether tok can end an operand, so that a following
+// bracket indexes it rather than starting a list literal.
+func endsOperand(tok token.Token) bool {
+	switch tok {
+	case token.IDENT, token.INT, token.FLOAT, token.STRING, token.CHAR, token.RPAREN, token.RBRACK, token.RBRACE:
+		return true
+	default:
+		return false
 	}
-	return 0, fmt.Errorf("non-numeric result")
 }
 
-func eval(expr ast.Expr) (interface{}, error) {
+func (e *evaluator) eval(expr ast.Expr) (interface{}, error) {
 	switch expr := expr.(type) {
 	case *ast.BinaryExpr:
-		// Check if operator is comparison
-		if isComparisonOperator(expr.Op) {
-			return evalBoolean(expr)
+		switch {
+		case expr.Op == token.LAND || expr.Op == token.LOR:
+			return e.evalLogical(expr)
+		case expr.Op == token.EQL && e.membership[e.fset.Position(expr.OpPos).Offset]:
+			return e.evalMembership(expr)
+		case isComparisonOperator(expr.Op):
+			return e.evalBoolean(expr)
 		}
-		return evalNumeric(expr)
+		return e.evalNumeric(expr)
 	case *ast.UnaryExpr:
-		operand, err := eval(expr.X)
+		operand, err := e.eval(expr.X)
 		if err != nil {
 			return 0, err
 		}
-		if expr.Op == token.SUB {
+		switch expr.Op {
+		case token.SUB:
 			if numOperand, ok := operand.(float64); ok {
 				return -numOperand, nil
 			}
 			return nil, fmt.Errorf("non-numeric operand for unary operator")
+		case token.ADD:
+			if numOperand, ok := operand.(float64); ok {
+				return numOperand, nil
+			}
+			return nil, fmt.Errorf("non-numeric operand for unary operator")
+		case token.NOT:
+			if boolOperand, ok := operand.(bool); ok {
+				return !boolOperand, nil
+			}
+			return nil, fmt.Errorf("non-boolean operand for !")
 		}
-		return 0, fmt.Errorf("unsupported unary operator: %s", expr.Op) // Handle other unary operators if needed
+		return 0, fmt.Errorf("unsupported unary operator: %s", expr.Op)
 
 	case *ast.ParenExpr:
-		return eval(expr.X)
+		return e.eval(expr.X)
+
+	case *ast.CallExpr:
+		name, ok := expr.Fun.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("unsupported function call")
+		}
+		fn, ok := lookupFunction(e.functions, name.Name)
+		if !ok {
+			return nil, fmt.Errorf("unknown function: %s", name.Name)
+		}
+		args := make([]interface{}, 0, len(expr.Args))
+		for _, arg := range expr.Args {
+			value, err := e.eval(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, value)
+		}
+		return fn.call(name.Name, args)
 
 	case *ast.BasicLit:
 		if expr.Kind == token.INT || expr.Kind == token.FLOAT {
 			return strconv.ParseFloat(expr.Value, 64)
 		}
 		if expr.Kind == token.STRING {
+			if s, err := strconv.Unquote(expr.Value); err == nil {
+				return s, nil
+			}
 			// Remove quotes from string literal
 			return expr.Value[1 : len(expr.Value)-1], nil
 		}
 		return nil, fmt.Errorf("unsupported literal type: %s", expr.Kind)
 
 	case *ast.Ident:
+		switch expr.Name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		if v, ok := e.variables[expr.Name]; ok {
+			return operand(v), nil
+		}
 		switch expr.Name {
 		case "pi":
 			return math.Pi, nil
@@ -120,17 +272,132 @@ func eval(expr ast.Expr) (interface{}, error) {
 			return expr.Name, nil
 		}
 
+	case *ast.SelectorExpr:
+		x, err := e.eval(expr.X)
+		if err != nil {
+			return nil, err
+		}
+		fields, ok := x.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot get field %s of a non-map", expr.Sel.Name)
+		}
+		field, ok := fields[expr.Sel.Name]
+		if !ok {
+			return nil, fmt.Errorf("field %s not found", expr.Sel.Name)
+		}
+		return operand(field), nil
+
+	case *ast.IndexExpr:
+		x, err := e.eval(expr.X)
+		if err != nil {
+			return nil, err
+		}
+		index, err := e.eval(expr.Index)
+		if err != nil {
+			return nil, err
+		}
+		switch x := x.(type) {
+		case []interface{}:
+			i, ok := index.(float64)
+			if !ok || i != math.Trunc(i) {
+				return nil, fmt.Errorf("list index must be a whole number")
+			}
+			if i < 0 || int(i) >= len(x) {
+				return nil, fmt.Errorf("list index %v out of range for length %d", i, len(x))
+			}
+			return operand(x[int(i)]), nil
+		case map[string]interface{}:
+			key, ok := index.(string)
+			if !ok {
+				return nil, fmt.Errorf("map key must be a string")
+			}
+			field, ok := x[key]
+			if !ok {
+				return nil, fmt.Errorf("key %q not found", key)
+			}
+			return operand(field), nil
+		}
+		return nil, fmt.Errorf("can only index a list or a map")
+
 	default:
 		return 0, fmt.Errorf("unsupported expression type: %T", expr)
 	}
 }
 
-func evalNumeric(expr *ast.BinaryExpr) (interface{}, error) {
-	x, err := eval(expr.X)
+// evalLogical evaluates && and ||, leaving the right operand unevaluated when
+// the left one decides the result.
+func (e *evaluator) evalLogical(expr *ast.BinaryExpr) (interface{}, error) {
+	x, err := e.evalBool(expr.X, expr.Op)
 	if err != nil {
 		return nil, err
 	}
-	y, err := eval(expr.Y)
+	if (expr.Op == token.LAND && !x) || (expr.Op == token.LOR && x) {
+		return x, nil
+	}
+	return e.evalBool(expr.Y, expr.Op)
+}
+
+func (e *evaluator) evalBool(expr ast.Expr, op token.Token) (bool, error) {
+	operand, err := e.eval(expr)
+	if err != nil {
+		return false, err
+	}
+	if b, ok := operand.(bool); ok {
+		return b, nil
+	}
+	return false, fmt.Errorf("non-boolean operand for %s", op)
+}
+
+// isComparable reports whether v can be compared with ==. Lists and maps
+// cannot, so they never match.
+func isComparable(v interface{}) bool {
+	switch v.(type) {
+	case []interface{}, map[string]interface{}:
+		return false
+	default:
+		return true
+	}
+}
+
+// evalMembership evaluates `x in y` as contains(y, x).
+func (e *evaluator) evalMembership(expr *ast.BinaryExpr) (interface{}, error) {
+	x, err := e.eval(expr.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := e.eval(expr.Y)
+	if err != nil {
+		return nil, err
+	}
+	return contains(y, x)
+}
+
+// contains reports whether list has an item equal to item, or whether string
+// list contains string item.
+func contains(list, item interface{}) (bool, error) {
+	switch list := list.(type) {
+	case []interface{}:
+		for _, v := range list {
+			if v = operand(v); isComparable(v) && v == item {
+				return true, nil
+			}
+		}
+		return false, nil
+	case string:
+		if substr, ok := item.(string); ok {
+			return strings.Contains(list, substr), nil
+		}
+		return false, fmt.Errorf("cannot look for a non-string in a string")
+	}
+	return false, fmt.Errorf("can only look for a value in a list or a string")
+}
+
+func (e *evaluator) evalNumeric(expr *ast.BinaryExpr) (interface{}, error) {
+	x, err := e.eval(expr.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := e.eval(expr.Y)
 	if err != nil {
 		return nil, err
 	}
@@ -160,12 +427,12 @@ func evalNumeric(expr *ast.BinaryExpr) (interface{}, error) {
 	}
 }
 
-func evalBoolean(expr *ast.BinaryExpr) (interface{}, error) {
-	x, err := eval(expr.X)
+func (e *evaluator) evalBoolean(expr *ast.BinaryExpr) (interface{}, error) {
+	x, err := e.eval(expr.X)
 	if err != nil {
 		return nil, err
 	}
-	y, err := eval(expr.Y)
+	y, err := e.eval(expr.Y)
 	if err != nil {
 		return nil, err
 	}
@@ -175,22 +442,36 @@ func evalBoolean(expr *ast.BinaryExpr) (interface{}, error) {
 		if yStr, okY := y.(string); okY {
 			switch expr.Op {
 			case token.EQL:
-				return boolToFloat(xStr == yStr), nil
+				return xStr == yStr, nil
 			case token.NEQ:
-				return boolToFloat(xStr != yStr), nil
+				return xStr != yStr, nil
 			case token.LSS:
-				return boolToFloat(xStr < yStr), nil
+				return xStr < yStr, nil
 			case token.GTR:
-				return boolToFloat(xStr > yStr), nil
+				return xStr > yStr, nil
 			case token.LEQ:
-				return boolToFloat(xStr <= yStr), nil
+				return xStr <= yStr, nil
 			case token.GEQ:
-				return boolToFloat(xStr >= yStr), nil
+				return xStr >= yStr, nil
 			}
 		}
 		return nil, fmt.Errorf("cannot compare string with non-string")
 	}
 
+	// Handle boolean equality
+	if xBool, okX := x.(bool); okX {
+		if yBool, okY := y.(bool); okY {
+			switch expr.Op {
+			case token.EQL:
+				return xBool == yBool, nil
+			case token.NEQ:
+				return xBool != yBool, nil
+			}
+			return nil, fmt.Errorf("unsupported boolean comparison operator: %s", expr.Op)
+		}
+		return nil, fmt.Errorf("cannot compare boolean with non-boolean")
+	}
+
 	// Handle numeric comparisons
 	xFloat, okX := x.(float64)
 	yFloat, okY := y.(float64)
@@ -200,22 +481,32 @@ func evalBoolean(expr *ast.BinaryExpr) (interface{}, error) {
 
 	switch expr.Op {
 	case token.EQL:
-		return boolToFloat(xFloat == yFloat), nil
+		return xFloat == yFloat, nil
 	case token.NEQ:
-		return boolToFloat(xFloat != yFloat), nil
+		return xFloat != yFloat, nil
 	case token.LSS:
-		return boolToFloat(xFloat < yFloat), nil
+		return xFloat < yFloat, nil
 	case token.GTR:
-		return boolToFloat(xFloat > yFloat), nil
+		return xFloat > yFloat, nil
 	case token.LEQ:
-		return boolToFloat(xFloat <= yFloat), nil
+		return xFloat <= yFloat, nil
 	case token.GEQ:
-		return boolToFloat(xFloat >= yFloat), nil
+		return xFloat >= yFloat, nil
 	default:
 		return nil, fmt.Errorf("unsupported comparison operator: %s", expr.Op)
 	}
 }
 
+// operand returns a variable in the form expressions compute with. Numbers
+// passed as json.Number become a float64, rounded if need be.
+func operand(v interface{}) interface{} {
+	if n, ok := v.(json.Number); ok {
+		f, _ := n.Float64()
+		return f
+	}
+	return v
+}
+
 func isComparisonOperator(op token.Token) bool {
 	switch op {
 	case token.EQL, token.NEQ, token.LSS, token.GTR, token.LEQ, token.GEQ:
@@ -224,10 +515,3 @@ func isComparisonOperator(op token.Token) bool {
 		return false
 	}
 }
-
-func boolToFloat(b bool) float64 {
-	if b {
-		return 1
-	}
-	return 0
-}
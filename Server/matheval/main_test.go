@@ -0,0 +1,165 @@
+package matheval
+
+import "testing"
+
+func TestVariablesKeepTheirType(t *testing.T) {
+	variables := map[string]interface{}{
+		"zip":     "01234",
+		"code":    "200",
+		"total":   250.0,
+		"paid":    true,
+		"allowed": []interface{}{"200", "201"},
+		"order":   map[string]interface{}{"code": "200", "lines": []interface{}{"7"}},
+	}
+	tests := []struct {
+		expression string
+		want       bool
+	}{
+		{`zip == "01234"`, true},
+		{`zip == "1234"`, false},
+		{`code == "2e2"`, false},
+		{`code == "200"`, true},
+		{`code in ["200", "201"]`, true},
+		{`code in allowed`, true},
+		{`order.code in allowed`, true},
+		{`order.lines[0] == "7"`, true},
+		{`total > 100`, true},
+		{`paid == true`, true},
+		{`number(zip) == 1234`, true},
+		{`number(code) in [200, 201]`, true},
+		{`string(total) == "250"`, true},
+		{`string(total) in allowed`, false},
+	}
+	for _, test := range tests {
+		got, err := EvaluateBooleanIn(test.expression, variables)
+		if err != nil {
+			t.Errorf("%s: %v", test.expression, err)
+		} else if got != test.want {
+			t.Errorf("%s = %v, want %v", test.expression, got, test.want)
+		}
+	}
+
+	for _, expression := range []string{`code == 200`, `zip < 5000`, `code + 1 > 0`, `number("abc") > 0`} {
+		if got, err := EvaluateBooleanIn(expression, variables); err == nil {
+			t.Errorf("%s = %v, want an error", expression, got)
+		}
+	}
+}
+
+func TestMembership(t *testing.T) {
+	variables := map[string]interface{}{
+		"status":   "paid",
+		"statuses": []interface{}{"paid", "shipped"},
+		"codes":    []interface{}{200.0, 201.0},
+		"nested":   []interface{}{[]interface{}{"paid"}, map[string]interface{}{"a": 1.0}},
+		"note":     "paid in full",
+		"order":    map[string]interface{}{"status": "shipped", "tags": []interface{}{"gift"}},
+	}
+	tests := []struct {
+		expression string
+		want       bool
+	}{
+		{`status in ["paid", "shipped"]`, true},
+		{`status in ["shipped"]`, false},
+		{`status in statuses`, true},
+		{`order.status in statuses`, true},
+		{`"gift" in order.tags`, true},
+		{`"Gift" in order.tags`, false},
+		{`"full" in note`, true},
+		{`"FULL" in note`, false},
+		{`"" in note`, true},
+		{`status in []`, false},
+		{`200 in codes`, true},
+		{`"200" in codes`, false},
+		{`1 + 1 in [2, 3]`, true},
+		{`!(status in ["refunded"])`, true},
+		{`status in statuses && 201 in codes`, true},
+		{`status in ["refunded"] || "full" in note`, true},
+		{`status == "paid" in [true]`, true},
+		{`nested in [nested]`, false},
+		{`"paid" in nested`, false},
+		{`upper(status) in ["PAID"]`, true},
+		{`status in ["PAID", lower("PAID")]`, true},
+	}
+	for _, test := range tests {
+		got, err := EvaluateBooleanIn(test.expression, variables)
+		if err != nil {
+			t.Errorf("%s: %v", test.expression, err)
+		} else if got != test.want {
+			t.Errorf("%s = %v, want %v", test.expression, got, test.want)
+		}
+	}
+
+	for _, expression := range []string{
+		`1 in note`,
+		`status in order`,
+		`status in 5`,
+	} {
+		if got, err := EvaluateBooleanIn(expression, variables); err == nil {
+			t.Errorf("%s = %v, want an error", expression, got)
+		}
+	}
+}
+
+const (
+	benchNumeric = "(order.total * 1.2 + items[0].price) / max(order.count, 1)"
+	benchBoolean = `order.total > 100 && order.status in ["paid", "shipped"] || !order.flagged`
+)
+
+var benchVariables = map[string]interface{}{
+	"order": map[string]interface{}{
+		"total":   250.0,
+		"count":   4.0,
+		"status":  "paid",
+		"flagged": false,
+	},
+	"items": []interface{}{
+		map[string]interface{}{"price": 19.99},
+	},
+}
+
+// BenchmarkNumericUncached parses the expression on every evaluation, as the
+// Evaluate functions do.
+func BenchmarkNumericUncached(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := EvaluateNumericIn(benchNumeric, benchVariables); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkNumericCached compiles the expression once and evaluates the
+// result, as a run does for loop conditions.
+func BenchmarkNumericCached(b *testing.B) {
+	x, err := Compile(benchNumeric)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := x.Numeric(benchVariables); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBooleanUncached(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := EvaluateBooleanIn(benchBoolean, benchVariables); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBooleanCached(b *testing.B) {
+	x, err := Compile(benchBoolean)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := x.Boolean(benchVariables); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
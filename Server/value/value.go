@@ -0,0 +1,502 @@
+package value
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Kind is the type of a Value.
+type Kind uint8
+
+const (
+	NullKind Kind = iota
+	BoolKind
+	NumberKind
+	StringKind
+	ListKind
+	MapKind
+	BytesKind
+	TimestampKind
+)
+
+func (k Kind) String() string {
+	switch k {
+	case NullKind:
+		return "null"
+	case BoolKind:
+		return "bool"
+	case NumberKind:
+		return "number"
+	case StringKind:
+		return "string"
+	case ListKind:
+		return "list"
+	case MapKind:
+		return "map"
+	case BytesKind:
+		return "bytes"
+	case TimestampKind:
+		return "timestamp"
+	default:
+		return fmt.Sprintf("Kind(%d)", k)
+	}
+}
+
+// Keys of the JSON objects that carry the kinds JSON has no literal for.
+const (
+	bytesKey     = "$bytes"
+	timestampKey = "$timestamp"
+	numberKey    = "$number"
+)
+
+// Value is a workflow variable. The zero Value is null.
+//
+// Numbers hold a float64, or their exact text as a json.Number when a float64
+// would lose digits, such as integers above 2^53 in an API response.
+type Value struct {
+	kind Kind
+	data interface{}
+}
+
+func Null() Value                       { return Value{} }
+func Bool(b bool) Value                 { return Value{kind: BoolKind, data: b} }
+func Number(n float64) Value            { return Value{kind: NumberKind, data: n} }
+func String(s string) Value             { return Value{kind: StringKind, data: s} }
+func List(items []Value) Value          { return Value{kind: ListKind, data: items} }
+func Map(fields map[string]Value) Value { return Value{kind: MapKind, data: fields} }
+func Bytes(b []byte) Value              { return Value{kind: BytesKind, data: b} }
+func Timestamp(t time.Time) Value       { return Value{kind: TimestampKind, data: t} }
+
+// From converts a Go value to a Value. Slices, arrays and maps convert
+// element by element; structs and other types go through their JSON form.
+func From(v interface{}) Value {
+	switch v := v.(type) {
+	case nil:
+		return Null()
+	case Value:
+		return v
+	case bool:
+		return Bool(v)
+	case float64:
+		return Number(v)
+	case float32:
+		return Number(float64(v))
+	case int:
+		return fromInt(int64(v))
+	case int32:
+		return Number(float64(v))
+	case int64:
+		return fromInt(v)
+	case string:
+		return String(v)
+	case []byte:
+		return Bytes(v)
+	case time.Time:
+		return Timestamp(v)
+	case json.Number:
+		if n, ok := numberFromText(v.String()); ok {
+			return n
+		}
+		return String(v.String())
+	case []Value:
+		return List(v)
+	case map[string]Value:
+		return Map(v)
+	case []interface{}:
+		items := make([]Value, len(v))
+		for i, item := range v {
+			items[i] = From(item)
+		}
+		return List(items)
+	case map[string]interface{}:
+		fields := make(map[string]Value, len(v))
+		for k, item := range v {
+			fields[k] = From(item)
+		}
+		return Map(fields)
+	}
+	return fromReflect(reflect.ValueOf(v))
+}
+
+func fromReflect(rv reflect.Value) Value {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return Null()
+		}
+		return From(rv.Elem().Interface())
+	case reflect.Bool:
+		return Bool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fromInt(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if n := rv.Uint(); n > maxExactInt {
+			return Value{kind: NumberKind, data: json.Number(strconv.FormatUint(n, 10))}
+		}
+		return Number(float64(rv.Uint()))
+	case reflect.Float32, reflect.Float64:
+		return Number(rv.Float())
+	case reflect.String:
+		return String(rv.String())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return Null()
+		}
+		items := make([]Value, rv.Len())
+		for i := range items {
+			items[i] = From(rv.Index(i).Interface())
+		}
+		return List(items)
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		fields := make(map[string]Value, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			fields[iter.Key().String()] = From(iter.Value().Interface())
+		}
+		return Map(fields)
+	}
+	data, err := json.Marshal(rv.Interface())
+	if err != nil {
+		return String(fmt.Sprintf("%v", rv.Interface()))
+	}
+	v, err := FromJSON(data)
+	if err != nil {
+		return String(string(data))
+	}
+	return v
+}
+
+// maxExactInt is the largest integer every smaller integer of which a float64
+// holds exactly, 2^53.
+const maxExactInt = 1 << 53
+
+func fromInt(n int64) Value {
+	if n > maxExactInt || n < -maxExactInt {
+		return Value{kind: NumberKind, data: json.Number(strconv.FormatInt(n, 10))}
+	}
+	return Number(float64(n))
+}
+
+// numberFromText returns the number a JSON number literal spells, keeping the
+// text when a float64 cannot hold the same value.
+func numberFromText(text string) (Value, bool) {
+	if !json.Valid([]byte(text)) || (text[0] != '-' && (text[0] < '0' || text[0] > '9')) {
+		return Null(), false
+	}
+	exact := Value{kind: NumberKind, data: json.Number(text)}
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(text), "e")
+	if hasExponent {
+		// Far beyond the range of a float64, and too costly to compare.
+		if e, err := strconv.Atoi(exponent); err != nil || e > 400 || e < -400 {
+			return exact, true
+		}
+	}
+	n, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return exact, true
+	}
+	// A float64 holds any 15 significant digits.
+	if len(strings.Trim(mantissa, "-0.")) <= 15 && !hasExponent {
+		return Number(n), true
+	}
+	want, _ := new(big.Rat).SetString(text)
+	got, _ := new(big.Rat).SetString(strconv.FormatFloat(n, 'g', -1, 64))
+	if want.Cmp(got) != 0 {
+		return exact, true
+	}
+	return Number(n), true
+}
+
+// FromJSON decodes JSON produced by MarshalJSON, or any plain JSON document.
+func FromJSON(data []byte) (Value, error) {
+	var v Value
+	if err := json.Unmarshal(data, &v); err != nil {
+		return Null(), err
+	}
+	return v, nil
+}
+
+func (v Value) Kind() Kind   { return v.kind }
+func (v Value) IsNull() bool { return v.kind == NullKind }
+
+func (v Value) AsBool() (bool, bool) {
+	b, ok := v.data.(bool)
+	return b, ok && v.kind == BoolKind
+}
+
+// AsNumber returns a number as a float64, rounded when it only fits as text.
+func (v Value) AsNumber() (float64, bool) {
+	if text, ok := v.data.(json.Number); ok {
+		n, _ := strconv.ParseFloat(text.String(), 64)
+		return n, v.kind == NumberKind
+	}
+	n, ok := v.data.(float64)
+	return n, ok && v.kind == NumberKind
+}
+
+func (v Value) AsString() (string, bool) {
+	s, ok := v.data.(string)
+	return s, ok && v.kind == StringKind
+}
+
+func (v Value) AsList() ([]Value, bool) {
+	items, ok := v.data.([]Value)
+	return items, ok && v.kind == ListKind
+}
+
+func (v Value) AsMap() (map[string]Value, bool) {
+	fields, ok := v.data.(map[string]Value)
+	return fields, ok && v.kind == MapKind
+}
+
+func (v Value) AsBytes() ([]byte, bool) {
+	b, ok := v.data.([]byte)
+	return b, ok && v.kind == BytesKind
+}
+
+func (v Value) AsTimestamp() (time.Time, bool) {
+	t, ok := v.data.(time.Time)
+	return t, ok && v.kind == TimestampKind
+}
+
+// String returns the text a value shows in templates, logs and the legacy
+// string variables of ReplayData. Lists and maps render as plain JSON.
+func (v Value) String() string {
+	switch v.kind {
+	case NullKind:
+		return ""
+	case BoolKind:
+		return strconv.FormatBool(v.data.(bool))
+	case NumberKind:
+		if text, ok := v.data.(json.Number); ok {
+			return text.String()
+		}
+		return formatNumber(v.data.(float64))
+	case StringKind:
+		return v.data.(string)
+	case BytesKind:
+		return base64.StdEncoding.EncodeToString(v.data.([]byte))
+	case TimestampKind:
+		return v.data.(time.Time).Format(time.RFC3339Nano)
+	default:
+		data, err := json.Marshal(v.Native())
+		if err != nil {
+			return fmt.Sprintf("%v", v.Native())
+		}
+		return string(data)
+	}
+}
+
+// Native returns the plain Go form of a value, as used by templates and when
+// calling out to JSON APIs: nil, bool, float64, string, []interface{} or
+// map[string]interface{}. Numbers a float64 cannot hold stay a json.Number,
+// and bytes and timestamps become their String form.
+func (v Value) Native() interface{} {
+	switch v.kind {
+	case NullKind:
+		return nil
+	case BoolKind, NumberKind, StringKind:
+		return v.data
+	case ListKind:
+		items := v.data.([]Value)
+		native := make([]interface{}, len(items))
+		for i, item := range items {
+			native[i] = item.Native()
+		}
+		return native
+	case MapKind:
+		fields := v.data.(map[string]Value)
+		native := make(map[string]interface{}, len(fields))
+		for k, item := range fields {
+			native[k] = item.Native()
+		}
+		return native
+	default:
+		return v.String()
+	}
+}
+
+// Struct converts variables to the google.protobuf.Struct sent with
+// ReplayData. Bytes and timestamps arrive as their String form.
+func Struct(variables map[string]Value) (*structpb.Struct, error) {
+	fields := make(map[string]interface{}, len(variables))
+	for k, v := range variables {
+		fields[k] = v.Native()
+	}
+	return structpb.NewStruct(fields)
+}
+
+// MarshalJSON encodes a value so that FromJSON restores it with the same
+// kind. Bytes, timestamps and non-finite numbers, which JSON cannot express
+// directly, are written as single-key objects such as {"$bytes": "..."}. A
+// map whose only key looks like one of those gets an extra "$" on the key.
+func (v Value) MarshalJSON() ([]byte, error) {
+	switch v.kind {
+	case NullKind:
+		return []byte("null"), nil
+	case BoolKind:
+		return json.Marshal(v.data.(bool))
+	case NumberKind:
+		if text, ok := v.data.(json.Number); ok {
+			return []byte(text), nil
+		}
+		n := v.data.(float64)
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return json.Marshal(map[string]string{numberKey: formatNumber(n)})
+		}
+		return []byte(strconv.FormatFloat(n, 'g', -1, 64)), nil
+	case StringKind:
+		return json.Marshal(v.data.(string))
+	case ListKind:
+		return json.Marshal(v.data.([]Value))
+	case MapKind:
+		fields := v.data.(map[string]Value)
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var buf bytes.Buffer
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			name := k
+			if len(keys) == 1 && isMarkerKey(k) {
+				name = "$" + k
+			}
+			key, _ := json.Marshal(name)
+			buf.Write(key)
+			buf.WriteByte(':')
+			item, err := fields[k].MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(item)
+		}
+		buf.WriteByte('}')
+		return buf.Bytes(), nil
+	case BytesKind:
+		return json.Marshal(map[string]string{bytesKey: v.String()})
+	case TimestampKind:
+		return json.Marshal(map[string]string{timestampKey: v.String()})
+	default:
+		return nil, fmt.Errorf("cannot marshal value of kind %s", v.kind)
+	}
+}
+
+func (v *Value) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var raw interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+	decoded, err := fromJSONValue(raw)
+	if err != nil {
+		return err
+	}
+	*v = decoded
+	return nil
+}
+
+func fromJSONValue(raw interface{}) (Value, error) {
+	switch raw := raw.(type) {
+	case []interface{}:
+		items := make([]Value, len(raw))
+		for i, item := range raw {
+			decoded, err := fromJSONValue(item)
+			if err != nil {
+				return Null(), err
+			}
+			items[i] = decoded
+		}
+		return List(items), nil
+	case map[string]interface{}:
+		if len(raw) == 1 {
+			for k, item := range raw {
+				text, ok := item.(string)
+				if !ok {
+					break
+				}
+				switch k {
+				case bytesKey:
+					b, err := base64.StdEncoding.DecodeString(text)
+					if err != nil {
+						return Null(), fmt.Errorf("invalid %s value: %w", bytesKey, err)
+					}
+					return Bytes(b), nil
+				case timestampKey:
+					t, err := time.Parse(time.RFC3339Nano, text)
+					if err != nil {
+						return Null(), fmt.Errorf("invalid %s value: %w", timestampKey, err)
+					}
+					return Timestamp(t), nil
+				case numberKey:
+					n, err := strconv.ParseFloat(text, 64)
+					if err != nil {
+						return Null(), fmt.Errorf("invalid %s value: %w", numberKey, err)
+					}
+					return Number(n), nil
+				}
+			}
+		}
+		fields := make(map[string]Value, len(raw))
+		for k, item := range raw {
+			decoded, err := fromJSONValue(item)
+			if err != nil {
+				return Null(), err
+			}
+			if len(raw) == 1 && strings.HasPrefix(k, "$$") && isMarkerKey(k) {
+				k = k[1:]
+			}
+			fields[k] = decoded
+		}
+		return Map(fields), nil
+	default:
+		return From(raw), nil
+	}
+}
+
+// isMarkerKey reports whether k is one of the keys MarshalJSON uses for bytes,
+// timestamps and numbers, with any number of extra leading "$".
+func isMarkerKey(k string) bool {
+	if !strings.HasPrefix(k, "$") {
+		return false
+	}
+	switch "$" + strings.TrimLeft(k, "$") {
+	case bytesKey, timestampKey, numberKey:
+		return true
+	}
+	return false
+}
+
+func formatNumber(n float64) string {
+	switch {
+	case math.IsNaN(n):
+		return "NaN"
+	case math.IsInf(n, 1):
+		return "+Inf"
+	case math.IsInf(n, -1):
+		return "-Inf"
+	}
+	if n == math.Trunc(n) && math.Abs(n) < 1e21 {
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	return strings.TrimSuffix(strconv.FormatFloat(n, 'g', -1, 64), "e+00")
+}
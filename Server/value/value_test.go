@@ -0,0 +1,90 @@
+package value
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	tests := []string{
+		`null`,
+		`true`,
+		`0.1`,
+		`-42`,
+		`9007199254740993`,
+		`-9223372036854775807`,
+		`12345678901234567890`,
+		`1.0000000000000000001`,
+		`1e400`,
+		`"text"`,
+		`[1,"two",[3],{"four":4}]`,
+		`{"id":123456789012345678,"items":[{"sku":98765432109876543210}]}`,
+		`{"$bytes":"aGk="}`,
+		`{"$timestamp":"2024-01-02T03:04:05.000000006Z"}`,
+		`{"$number":"NaN"}`,
+		`{"$$bytes":"not bytes"}`,
+	}
+	for _, text := range tests {
+		v, err := FromJSON([]byte(text))
+		if err != nil {
+			t.Errorf("FromJSON(%s): %v", text, err)
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Errorf("Marshal(%s): %v", text, err)
+			continue
+		}
+		if string(data) != text {
+			t.Errorf("%s round-tripped to %s", text, data)
+		}
+	}
+}
+
+func TestKindsSurviveJSON(t *testing.T) {
+	stamp := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	variables := map[string]Value{
+		"bytes":  Bytes([]byte("hi")),
+		"stamp":  Timestamp(stamp),
+		"inf":    Number(math.Inf(1)),
+		"big":    From(int64(1<<62 + 1)),
+		"marker": Map(map[string]Value{"$bytes": String("not bytes")}),
+		"list":   From([]interface{}{int32(1), "a", nil, true}),
+	}
+	data, err := json.Marshal(variables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]Value
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, variables) {
+		t.Errorf("variables came back as %v, want %v", decoded, variables)
+	}
+}
+
+func TestLargeNumbers(t *testing.T) {
+	v, err := FromJSON([]byte(`9007199254740993`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Kind() != NumberKind {
+		t.Errorf("kind %s, want number", v.Kind())
+	}
+	if got := v.String(); got != "9007199254740993" {
+		t.Errorf("String() = %s, want 9007199254740993", got)
+	}
+	if n, ok := v.AsNumber(); !ok || n != 9007199254740992 {
+		t.Errorf("AsNumber() = %v, %v, want the nearest float64", n, ok)
+	}
+	if got := From(uint64(math.MaxUint64)).String(); got != "18446744073709551615" {
+		t.Errorf("From(MaxUint64).String() = %s", got)
+	}
+	if got := From(json.Number("0.10")).String(); got != "0.1" {
+		t.Errorf("a short decimal is kept as %s, want 0.1", got)
+	}
+}
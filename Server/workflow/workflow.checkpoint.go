@@ -0,0 +1,344 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	DB "github.com/raenardcruz/floowsynk/Database"
+	"github.com/raenardcruz/floowsynk/Server/value"
+)
+
+// A durable run holds a Redis lease while it executes. Runs left RUNNING
+// whose lease expired belonged to a server that stopped and are recovered.
+const (
+	runLeaseTTL     = 30 * time.Second
+	runLeaseRefresh = 10 * time.Second
+)
+
+// instanceID identifies this server as the owner of the leases it holds.
+var instanceID = uuid.NewString()
+
+func runLeaseKey(id string) string {
+	return "run:lease:" + id
+}
+
+func checkpointKey(nodeId string, occurrence int) string {
+	return fmt.Sprintf("%s#%d", nodeId, occurrence)
+}
+
+// RecoverRuns resumes every durable run that is still RUNNING but no longer
+// leased by a live server. It runs at startup and with every scheduler tick,
+// so runs whose lease was still held at startup are recovered once it
+// expires. Runs are only considered once they are older than a lease, which
+// keeps it away from runs that are being created.
+func RecoverRuns(ctx context.Context, dbcon *DB.DatabaseConnection, producer *sarama.SyncProducer) {
+	runs, err := dbcon.GetUnfinishedRuns(time.Now().Add(-runLeaseTTL).UTC().Unix())
+	if err != nil {
+		log.Printf("Error loading unfinished runs: %v", err)
+		return
+	}
+	for _, run := range runs {
+		acquired, err := DB.AcquireLease(ctx, runLeaseKey(run.ID), instanceID, runLeaseTTL)
+		if err != nil {
+			log.Printf("Error acquiring lease for run %s: %v", run.ID, err)
+			continue
+		}
+		if !acquired {
+			continue
+		}
+		// The run may have finished or parked, releasing its lease, since it
+		// was loaded.
+		current, err := dbcon.GetRun(run.ID)
+		if err != nil || current.Status != DB.RunStatusRunning {
+			if err != nil {
+				log.Printf("Error loading run %s: %v", run.ID, err)
+			}
+			DB.ReleaseLease(ctx, runLeaseKey(run.ID))
+			continue
+		}
+		resumeRun(ctx, dbcon, producer, current)
+	}
+}
+
+// RunSnapshot returns the workflow definition a durable run started with.
+func RunSnapshot(run DB.Run) (*proto.Workflow, error) {
+	var workflow proto.Workflow
+	if err := json.Unmarshal(run.Workflow, &workflow); err != nil {
+		return nil, err
+	}
+	return &workflow, nil
+}
+
+// resumeRun continues a durable run whose lease this server holds.
+func resumeRun(ctx context.Context, dbcon *DB.DatabaseConnection, producer *sarama.SyncProducer, run DB.Run) {
+	workflow, err := RunSnapshot(run)
+	if err != nil {
+		log.Printf("Error decoding workflow of run %s: %v", run.ID, err)
+		dbcon.UpdateRunStatus(run.ID, DB.RunStatusFailed)
+		DB.ReleaseLease(ctx, runLeaseKey(run.ID))
+		return
+	}
+	variables := make(map[string]value.Value)
+	if len(run.Variables) > 0 {
+		if err := json.Unmarshal(run.Variables, &variables); err != nil {
+			log.Printf("Error decoding start variables of run %s: %v", run.ID, err)
+		}
+	}
+	wp := &WorkflowProcessor{
+		ID:               run.ID,
+		Workflow:         workflow,
+		ProcessVariables: variables,
+		DBcon:            *dbcon,
+		Producer:         producer,
+		Durable:          true,
+		ParentID:         run.ParentID,
+		StartNodeID:      run.StartNodeID,
+		StartedBy:        run.StartedBy,
+	}
+	log.Printf("Resuming run %s of workflow %s", run.ID, run.WorkflowID)
+	go func() {
+		if err := wp.ResumeWorkflow(ctx); err != nil {
+			log.Printf("Resumed run %s failed: %v", wp.ID, err)
+		}
+	}()
+}
+
+// createDurableRun stores the run with a snapshot of its workflow and start
+// variables, and takes the run's lease.
+func (wp *WorkflowProcessor) createDurableRun(ctx context.Context, variables map[string]value.Value) error {
+	snapshot, err := json.Marshal(wp.Workflow)
+	if err != nil {
+		return err
+	}
+	start, err := json.Marshal(variables)
+	if err != nil {
+		return err
+	}
+	if err := wp.DBcon.CreateRun(&DB.Run{
+		ID:          wp.ID,
+		WorkflowID:  wp.Workflow.Id,
+		ParentID:    wp.ParentID,
+		Workflow:    snapshot,
+		StartNodeID: wp.StartNodeID,
+		StartedBy:   wp.StartedBy,
+		Variables:   start,
+		Status:      DB.RunStatusRunning,
+	}); err != nil {
+		return err
+	}
+	if _, err := DB.AcquireLease(ctx, runLeaseKey(wp.ID), instanceID, runLeaseTTL); err != nil {
+		log.Printf("Error acquiring lease for run %s: %v", wp.ID, err)
+	}
+	return nil
+}
+
+// holdRunLease keeps the run's lease alive until the returned func is called,
+// which releases it.
+func (wp *WorkflowProcessor) holdRunLease(ctx context.Context) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(runLeaseRefresh)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := DB.RenewLease(context.Background(), runLeaseKey(wp.ID), runLeaseTTL); err != nil {
+					log.Printf("Error renewing lease for run %s: %v", wp.ID, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		if err := DB.ReleaseLease(context.Background(), runLeaseKey(wp.ID)); err != nil {
+			log.Printf("Error releasing lease for run %s: %v", wp.ID, err)
+		}
+	}
+}
+
+// parkDurableRun marks the run WAITING, keeping its checkpoints for when it
+// wakes.
+func (wp *WorkflowProcessor) parkDurableRun(wakeAt time.Time) {
+	if !wp.Durable {
+		return
+	}
+	var wakeAtUnix int64
+	if !wakeAt.IsZero() {
+		wakeAtUnix = wakeAt.UTC().Unix()
+	}
+	if err := wp.DBcon.ParkRun(wp.ID, wakeAtUnix); err != nil {
+		log.Printf("Error parking run %s: %v", wp.ID, err)
+	}
+}
+
+// finishDurableRun records the final status of a durable run, drops its
+// checkpoints, which are only needed while the run can still be resumed, and
+// closes the approvals it still had open.
+func (wp *WorkflowProcessor) finishDurableRun(status proto.NodeStatus) {
+	if !wp.Durable {
+		return
+	}
+	if err := wp.DBcon.UpdateRunStatus(wp.ID, int32(status)); err != nil {
+		log.Printf("Error updating status of run %s: %v", wp.ID, err)
+	}
+	if err := wp.DBcon.DeleteCheckpoints(wp.ID); err != nil {
+		log.Printf("Error deleting checkpoints of run %s: %v", wp.ID, err)
+	}
+	if err := wp.DBcon.CloseApprovals(wp.ID); err != nil {
+		log.Printf("Error closing approvals of run %s: %v", wp.ID, err)
+	}
+}
+
+// nextOccurrence counts one more execution of nodeId and returns its number.
+func (wp *WorkflowProcessor) nextOccurrence(nodeId string) int {
+	owner := wp.owner()
+	owner.journalMu.Lock()
+	defer owner.journalMu.Unlock()
+	if owner.occurrences == nil {
+		owner.occurrences = make(map[string]int)
+	}
+	owner.occurrences[nodeId]++
+	return owner.occurrences[nodeId]
+}
+
+func (wp *WorkflowProcessor) loadJournal(checkpoints []DB.RunCheckpoint) {
+	wp.journalMu.Lock()
+	defer wp.journalMu.Unlock()
+	wp.journal = make(map[string]DB.RunCheckpoint, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		wp.journal[checkpointKey(checkpoint.NodeID, checkpoint.Occurrence)] = checkpoint
+		if checkpoint.Step >= wp.Step {
+			wp.Step = checkpoint.Step + 1
+		}
+	}
+}
+
+// journaled returns the checkpoint of an execution that completed before the
+// run was resumed.
+func (wp *WorkflowProcessor) journaled(nodeId string, occurrence int) (DB.RunCheckpoint, bool) {
+	owner := wp.owner()
+	owner.journalMu.Lock()
+	defer owner.journalMu.Unlock()
+	checkpoint, ok := owner.journal[checkpointKey(nodeId, occurrence)]
+	return checkpoint, ok
+}
+
+func (wp *WorkflowProcessor) restoreCheckpoint(checkpoint DB.RunCheckpoint) {
+	if len(checkpoint.Variables) == 0 {
+		return
+	}
+	var variables map[string]value.Value
+	if err := json.Unmarshal(checkpoint.Variables, &variables); err != nil {
+		log.Printf("Error restoring variables of run %s at node %s: %v", wp.ID, checkpoint.NodeID, err)
+		return
+	}
+	wp.varsMu.Lock()
+	wp.ProcessVariables = variables
+	wp.varsMu.Unlock()
+}
+
+// saveCheckpoint records that node completed with sourceHandle, together with
+// the state needed to continue after it.
+func (wp *WorkflowProcessor) saveCheckpoint(node *proto.Node, occurrence int, sourceHandle string) {
+	if !wp.Durable {
+		return
+	}
+	variables, err := json.Marshal(wp.snapshotVariables())
+	if err != nil {
+		log.Printf("Error marshaling checkpoint variables of run %s: %v", wp.ID, err)
+		return
+	}
+	// Most nodes, loop bodies especially, leave the variables as they were.
+	// Their checkpoints store none and restoring them keeps the variables the
+	// processor's previous checkpoint restored.
+	if bytes.Equal(variables, wp.lastSaved) {
+		variables = nil
+	} else {
+		wp.lastSaved = variables
+	}
+	emitter := wp.emitRoot()
+	emitter.emitMu.Lock()
+	step := emitter.Step
+	emitter.emitMu.Unlock()
+	if err := wp.DBcon.SaveCheckpoint(&DB.RunCheckpoint{
+		ProcessID:    wp.ID,
+		NodeID:       node.Id,
+		Occurrence:   occurrence,
+		SourceHandle: sourceHandle,
+		Variables:    variables,
+		Step:         step,
+	}); err != nil {
+		log.Printf("Error saving checkpoint of run %s at node %s: %v", wp.ID, node.Id, err)
+	}
+}
+
+// VariablesBeforeNode rebuilds the variables a run held before its last
+// execution of nodeId, from the variables recorded with the last completed
+// step ahead of it. history must be ordered by ProcessSequence. Only steps the
+// run took in its own scope count: parallel branches, foreach items and
+// subprocesses record variables the run itself never held.
+func VariablesBeforeNode(history []DB.ReplayData, nodeId string) (map[string]value.Value, error) {
+	cutoff := -1
+	for i, record := range history {
+		if record.NodeID == nodeId && !record.Scoped {
+			cutoff = i
+		}
+	}
+	if cutoff < 0 {
+		return nil, fmt.Errorf("node %s was not executed in the run's own scope", nodeId)
+	}
+	for i := cutoff - 1; i >= 0; i-- {
+		record := history[i]
+		if record.NodeID == "" || record.Scoped || record.Status != int32(proto.NodeStatus_COMPLETED) {
+			continue
+		}
+		if variables, err := RecordVariables(record); err == nil && variables != nil {
+			return variables, nil
+		}
+	}
+	return make(map[string]value.Value), nil
+}
+
+// RecordVariables returns the variables stored with a ReplayData record, or
+// nil when it has none. Records written before variables were stored with
+// their kinds only have the text form, where lists and maps are JSON and are
+// decoded again.
+func RecordVariables(record DB.ReplayData) (map[string]value.Value, error) {
+	if len(record.TypedVariables) > 0 {
+		var typed map[string]value.Value
+		if err := json.Unmarshal(record.TypedVariables, &typed); err != nil {
+			return nil, err
+		}
+		if typed != nil {
+			return typed, nil
+		}
+	}
+	if len(record.Variables) == 0 {
+		return nil, nil
+	}
+	var stored map[string]string
+	if err := json.Unmarshal(record.Variables, &stored); err != nil || stored == nil {
+		return nil, err
+	}
+	variables := make(map[string]value.Value, len(stored))
+	for k, v := range stored {
+		if strings.HasPrefix(v, "[") || strings.HasPrefix(v, "{") {
+			if decoded, err := value.FromJSON([]byte(v)); err == nil {
+				variables[k] = decoded
+				continue
+			}
+		}
+		variables[k] = value.String(v)
+	}
+	return variables, nil
+}
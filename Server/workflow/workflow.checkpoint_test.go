@@ -0,0 +1,30 @@
+package workflow
+
+import (
+	"testing"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	DB "github.com/raenardcruz/floowsynk/Database"
+)
+
+func TestVariablesBeforeNodeUsesTheRunsOwnScope(t *testing.T) {
+	completed := int32(proto.NodeStatus_COMPLETED)
+	history := []DB.ReplayData{
+		{NodeID: "0", Status: completed, TypedVariables: DB.JSONB(`{"step":"trigger"}`)},
+		{NodeID: "a", Status: completed, TypedVariables: DB.JSONB(`{"step":"a"}`)},
+		{NodeID: "b", Status: completed, TypedVariables: DB.JSONB(`{"step":"branch"}`), Scoped: true},
+		{NodeID: "s/1", Status: completed, TypedVariables: DB.JSONB(`{"step":"subprocess"}`), Scoped: true},
+		{NodeID: "c", Status: int32(proto.NodeStatus_FAILED), TypedVariables: DB.JSONB(`{"step":"c"}`)},
+		{NodeID: "d", Status: completed, TypedVariables: DB.JSONB(`{"step":"item"}`), Scoped: true},
+	}
+	variables, err := VariablesBeforeNode(history, "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if step := variables["step"].String(); step != "a" {
+		t.Errorf("resuming at c starts from the variables of %q, want a", step)
+	}
+	if _, err := VariablesBeforeNode(history, "d"); err == nil {
+		t.Error("resuming at a node that only ran in a foreach item succeeded")
+	}
+}
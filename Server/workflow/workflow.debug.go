@@ -0,0 +1,181 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	"github.com/raenardcruz/floowsynk/Server/value"
+)
+
+// DEBUG_RUN_CHANNEL is the Redis channel used to forward DebugControl commands
+// to the server replica that is executing the debug run.
+const DEBUG_RUN_CHANNEL = "workflow:debug"
+
+// Actions of a DebugCommand.
+const (
+	DebugStep        = "step"        // run the paused node and pause before the next one
+	DebugContinue    = "continue"    // run until the next breakpoint
+	DebugPause       = "pause"       // pause before the next node
+	DebugBreakpoints = "breakpoints" // replace the breakpoints
+	DebugVariables   = "variables"   // set variables, in the paused scope when paused
+)
+
+// DebugMessage is a DebugControl command forwarded on DEBUG_RUN_CHANNEL,
+// together with the user who sent it.
+type DebugMessage struct {
+	UserID  string          `json:"userId"`
+	Command json.RawMessage `json:"command"` // the DebugCommand, as protojson
+}
+
+// debugSession pauses a debug run before nodes with a breakpoint, or before
+// every node while stepping. Parallel branches pause one at a time.
+type debugSession struct {
+	pauseMu sync.Mutex // held by the branch that is paused
+
+	mu          sync.Mutex // guards the fields below
+	breakpoints map[string]bool
+	stepping    bool
+	root        *WorkflowProcessor
+	paused      *WorkflowProcessor
+	signals     chan string
+	owner       string // user who started the debug run
+}
+
+// activeDebugs holds the session of every debug run executing on this server,
+// keyed by WorkflowProcessor.ID.
+var activeDebugs = struct {
+	sync.Mutex
+	sessions map[string]*debugSession
+}{sessions: make(map[string]*debugSession)}
+
+func (wp *WorkflowProcessor) registerDebug() func() {
+	session := &debugSession{
+		breakpoints: toSet(wp.Breakpoints),
+		stepping:    wp.PauseAtStart,
+		root:        wp,
+		signals:     make(chan string, 8),
+		owner:       wp.StartedBy,
+	}
+	wp.debug = session
+	activeDebugs.Lock()
+	activeDebugs.sessions[wp.ID] = session
+	activeDebugs.Unlock()
+	return func() {
+		activeDebugs.Lock()
+		delete(activeDebugs.sessions, wp.ID)
+		activeDebugs.Unlock()
+	}
+}
+
+// DebugControl applies cmd from userId to a debug run executing on this server
+// and reports whether it was found. Only the user who started the debug run
+// may control it.
+func DebugControl(cmd *proto.DebugCommand, userId string) (bool, error) {
+	switch cmd.Action {
+	case DebugStep, DebugContinue, DebugPause, DebugBreakpoints, DebugVariables:
+	default:
+		return false, fmt.Errorf("unknown debug action %q", cmd.Action)
+	}
+	activeDebugs.Lock()
+	session, ok := activeDebugs.sessions[cmd.ProcessId]
+	activeDebugs.Unlock()
+	if !ok {
+		return false, nil
+	}
+	if session.owner == "" || session.owner != userId {
+		return true, fmt.Errorf("debug run %s was started by another user", cmd.ProcessId)
+	}
+	session.apply(cmd)
+	return true, nil
+}
+
+func (s *debugSession) apply(cmd *proto.DebugCommand) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch cmd.Action {
+	case DebugStep:
+		s.stepping = true
+	case DebugContinue:
+		s.stepping = false
+	case DebugPause:
+		s.stepping = true
+		return
+	case DebugBreakpoints:
+		s.breakpoints = toSet(cmd.Breakpoints)
+		return
+	case DebugVariables:
+		scope := s.root
+		if s.paused != nil {
+			scope = s.paused
+		}
+		for name, v := range cmd.Variables.GetFields() {
+			scope.setVariable(name, value.From(v.AsInterface()))
+		}
+	}
+	if s.paused != nil {
+		select {
+		case s.signals <- cmd.Action:
+		default:
+		}
+	}
+}
+
+// debugPause blocks before node runs when the run is being debugged and the
+// node has a breakpoint or the run is stepping. While paused the run reports
+// its variables with a PAUSED record, again after each change to them.
+func (wp *WorkflowProcessor) debugPause(ctx context.Context, node *proto.Node) error {
+	session := wp.emitRoot().debug
+	if session == nil {
+		return nil
+	}
+	nodeId := wp.nodePrefix + node.Id
+	session.mu.Lock()
+	pause := session.stepping || session.breakpoints[nodeId]
+	session.mu.Unlock()
+	if !pause {
+		return nil
+	}
+
+	session.pauseMu.Lock()
+	defer session.pauseMu.Unlock()
+	session.mu.Lock()
+	if !session.stepping && !session.breakpoints[nodeId] {
+		// Resumed while this branch waited for another one to be stepped.
+		session.mu.Unlock()
+		return nil
+	}
+	session.paused = wp
+	for len(session.signals) > 0 {
+		<-session.signals
+	}
+	session.mu.Unlock()
+	defer func() {
+		session.mu.Lock()
+		session.paused = nil
+		session.mu.Unlock()
+	}()
+
+	wp.UpdateStatus(node, proto.NodeStatus_PAUSED, nil, fmt.Sprintf("Paused before node %s", nodeId), true)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case action := <-session.signals:
+			if action != DebugVariables {
+				return nil
+			}
+			wp.UpdateStatus(node, proto.NodeStatus_PAUSED, nil, "Variables updated", true)
+		}
+	}
+}
+
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[item] = true
+	}
+	return set
+}
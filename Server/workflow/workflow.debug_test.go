@@ -0,0 +1,49 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+)
+
+func TestOnlyTheOwnerControlsADebugRun(t *testing.T) {
+	wp := &WorkflowProcessor{ID: t.Name(), Debug: true, PauseAtStart: true, StartedBy: "alice", Workflow: &proto.Workflow{Id: t.Name(), Nodes: []*proto.Node{
+		{Id: "0", Nodetype: "defaultnode"},
+	}}}
+	done := make(chan error, 1)
+	go func() { done <- wp.StartWorkflow(context.Background()) }()
+
+	cmd := &proto.DebugCommand{ProcessId: wp.ID, Action: DebugContinue}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		found, err := DebugControl(cmd, "bob")
+		if found {
+			if err == nil {
+				t.Fatal("another user continued the debug run")
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the debug run never started")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	select {
+	case <-done:
+		t.Fatal("the debug run went on after a command from another user")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if found, err := DebugControl(cmd, "alice"); !found || err != nil {
+		t.Fatalf("the owner's command was not applied: %v, %v", found, err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the debug run did not continue for its owner")
+	}
+}
@@ -0,0 +1,278 @@
+package workflow
+
+import (
+	"fmt"
+	"go/token"
+	htmltemplate "html/template"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	m "github.com/raenardcruz/floowsynk/Server/matheval"
+	"github.com/raenardcruz/floowsynk/Server/value"
+)
+
+// maxCachedTemplates bounds the templates a run keeps parsed.
+const maxCachedTemplates = 1024
+
+// compileCache keeps what a run has compiled, on its root processor, so that
+// loops do not parse the same text on every iteration: templates by text, and
+// the last expression each node compiled.
+type compileCache struct {
+	mu          sync.Mutex
+	templates   map[templateKey]compiledTemplate
+	expressions map[string]compiledExpression
+}
+
+type templateKey struct {
+	text  string
+	plain bool
+}
+
+// compiledTemplate is a parsed html/template or text/template.
+type compiledTemplate interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+type compiledExpression struct {
+	source string
+	expr   *m.Expression
+}
+
+// referencePattern matches, at the start of the text, a template that only
+// names a variable or a path into one, such as {{.order.total}}.
+var referencePattern = regexp.MustCompile(`^\{\{-?\s*\.([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*)\s*-?\}\}`)
+
+// preparedExpression is the expression of a node rendered for one
+// evaluation.
+type preparedExpression struct {
+	display   string                 // the expression with the variables rendered in, for messages
+	source    string                 // what matheval compiles
+	variables map[string]interface{} // what source is evaluated against
+}
+
+// evaluateBoolean evaluates the expression of a condition or while node.
+func (wp *WorkflowProcessor) evaluateBoolean(node *proto.Node, prepared preparedExpression) (bool, error) {
+	expr, err := wp.compileExpression(node, prepared.source)
+	if err != nil {
+		return false, err
+	}
+	return expr.Boolean(prepared.variables)
+}
+
+// evaluateNumeric evaluates the expression of a math node.
+func (wp *WorkflowProcessor) evaluateNumeric(node *proto.Node, prepared preparedExpression) (float64, error) {
+	expr, err := wp.compileExpression(node, prepared.source)
+	if err != nil {
+		return 0, err
+	}
+	return expr.Numeric(prepared.variables)
+}
+
+// evaluateValue evaluates the expression of a switch node.
+func (wp *WorkflowProcessor) evaluateValue(node *proto.Node, prepared preparedExpression) (interface{}, error) {
+	expr, err := wp.compileExpression(node, prepared.source)
+	if err != nil {
+		return nil, err
+	}
+	return expr.Value(prepared.variables)
+}
+
+// compileExpression compiles source, the prepared expression of node, reusing
+// what the node compiled last time when its source has not changed.
+func (wp *WorkflowProcessor) compileExpression(node *proto.Node, source string) (*m.Expression, error) {
+	key := wp.nodePrefix + node.Id
+	root := wp.emitRoot()
+	cache := &root.compiled
+	cache.mu.Lock()
+	cached, ok := cache.expressions[key]
+	cache.mu.Unlock()
+	if ok && cached.source == source {
+		return cached.expr, nil
+	}
+	expr, err := m.CompileWith(source, root.Functions)
+	if err != nil {
+		return nil, err
+	}
+	cache.mu.Lock()
+	if cache.expressions == nil {
+		cache.expressions = make(map[string]compiledExpression)
+	}
+	cache.expressions[key] = compiledExpression{source: source, expr: expr}
+	cache.mu.Unlock()
+	return expr, nil
+}
+
+// parseTemplate parses text as a template, once per run. Plain templates use
+// text/template, which leaves values unescaped.
+func (wp *WorkflowProcessor) parseTemplate(text string, plain bool) (compiledTemplate, error) {
+	key := templateKey{text: text, plain: plain}
+	cache := &wp.emitRoot().compiled
+	cache.mu.Lock()
+	tmpl, ok := cache.templates[key]
+	cache.mu.Unlock()
+	if ok {
+		return tmpl, nil
+	}
+	var err error
+	if plain {
+		tmpl, err = texttemplate.New("template").Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	} else {
+		tmpl, err = htmltemplate.New("template").Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cache.mu.Lock()
+	if cache.templates == nil {
+		cache.templates = make(map[templateKey]compiledTemplate)
+	}
+	if len(cache.templates) < maxCachedTemplates {
+		cache.templates[key] = tmpl
+	}
+	cache.mu.Unlock()
+	return tmpl, nil
+}
+
+// prepareExpression renders the expression of node against one snapshot of
+// the variables, converted once for both the display text and matheval.
+//
+// References to variables outside string literals become the bare path in
+// the source, which matheval resolves against the typed variables, and string
+// literals holding templates become identifiers bound to the rendered text,
+// which stays a string. A reference to a string outside a literal is bound
+// to the value the text used to have once rendered into the expression: a
+// number or a boolean when it reads as one. Either way a variable's value can
+// no longer change the expression it is used in. Other templates render as
+// before.
+func (wp *WorkflowProcessor) prepareExpression(node *proto.Node) preparedExpression {
+	expression := node.Data.GetExpression()
+	plain := node.Data.GetPlainText()
+	snapshot := wp.snapshotVariables()
+	data := templateData(snapshot)
+	variables := make(map[string]interface{}, len(snapshot))
+	for k, v := range snapshot {
+		variables[k] = v.Native()
+	}
+
+	var builder strings.Builder
+	var quote byte
+	literals, start, last := 0, 0, 0
+	for i := 0; i < len(expression); i++ {
+		c := expression[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+				literal := expression[start : i+1]
+				if !strings.Contains(literal, "{{") {
+					continue
+				}
+				name := literalName(variables, literals)
+				literals++
+				variables[name] = wp.executeWith(unquoteLiteral(literal), data, plain)
+				builder.WriteString(expression[last:start])
+				builder.WriteString(name)
+				last = i + 1
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+			start = i
+		case c == '{':
+			match := referencePattern.FindStringSubmatch(expression[i:])
+			if match == nil || !isReference(snapshot, match[1]) {
+				continue
+			}
+			builder.WriteString(expression[last:i])
+			if text, ok := lookupPath(snapshot, match[1]).AsString(); ok {
+				name := literalName(variables, literals)
+				literals++
+				variables[name] = textValue(text)
+				builder.WriteString(name)
+			} else {
+				builder.WriteString(match[1])
+			}
+			i += len(match[0]) - 1
+			last = i + 1
+		}
+	}
+	builder.WriteString(expression[last:])
+
+	source := builder.String()
+	if strings.Contains(source, "{{") {
+		source = wp.executeWith(source, data, plain)
+	}
+	return preparedExpression{
+		display:   wp.executeWith(expression, data, plain),
+		source:    source,
+		variables: variables,
+	}
+}
+
+// literalName returns the identifier the n-th templated string literal of an
+// expression is bound to, skipping names that variables already use.
+func literalName(variables map[string]interface{}, n int) string {
+	name := fmt.Sprintf("literal_%d", n)
+	for {
+		if _, ok := variables[name]; !ok {
+			return name
+		}
+		name = "_" + name
+	}
+}
+
+// unquoteLiteral returns the text of a string literal, as matheval reads it.
+func unquoteLiteral(literal string) string {
+	if text, err := strconv.Unquote(literal); err == nil {
+		return text
+	}
+	return literal[1 : len(literal)-1]
+}
+
+// lookupPath returns the value path names in variables, or null when it
+// names nothing.
+func lookupPath(variables map[string]value.Value, path string) value.Value {
+	names := strings.Split(path, ".")
+	v := variables[names[0]]
+	for _, name := range names[1:] {
+		fields, ok := v.AsMap()
+		if !ok {
+			return value.Null()
+		}
+		v = fields[name]
+	}
+	return v
+}
+
+// textValue returns the value text has when written into an expression as
+// is: a finite number or a boolean when it reads as one, the text otherwise.
+func textValue(text string) interface{} {
+	trimmed := strings.TrimSpace(text)
+	if n, err := strconv.ParseFloat(trimmed, 64); err == nil && !math.IsNaN(n) && !math.IsInf(n, 0) {
+		return n
+	}
+	if trimmed == "true" || trimmed == "false" {
+		return trimmed == "true"
+	}
+	return text
+}
+
+// isReference reports whether path names one of variables and can be
+// written as a bare path, which rules out keywords of the expression syntax.
+func isReference(variables map[string]value.Value, path string) bool {
+	names := strings.Split(path, ".")
+	for _, name := range names {
+		if token.IsKeyword(name) || name == "in" || name == "true" || name == "false" {
+			return false
+		}
+	}
+	_, ok := variables[names[0]]
+	return ok
+}
@@ -0,0 +1,101 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	m "github.com/raenardcruz/floowsynk/Server/matheval"
+	"github.com/raenardcruz/floowsynk/Server/value"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func variablesOf(fields map[string]interface{}) map[string]value.Value {
+	variables := make(map[string]value.Value, len(fields))
+	for k, v := range fields {
+		variables[k] = value.From(v)
+	}
+	return variables
+}
+
+// runCondition runs a condition node on variables and returns the text of
+// the branch it took, or "" when it failed.
+func runCondition(t *testing.T, expression string, variables map[string]interface{}) string {
+	t.Helper()
+	wp := &WorkflowProcessor{ID: t.Name(), Workflow: &proto.Workflow{Id: t.Name(), Nodes: []*proto.Node{
+		{Id: "0", Nodetype: "defaultnode"},
+		{Id: "c", Nodetype: "condition", Data: &proto.NodeData{Expression: ptr(expression)}},
+		{Id: "t", Nodetype: "text", Data: &proto.NodeData{Message: ptr(TRUE), Variable: ptr("branch")}},
+		{Id: "f", Nodetype: "text", Data: &proto.NodeData{Message: ptr(FALSE), Variable: ptr("branch")}},
+	}, Edges: []*proto.Edge{
+		{Source: "0", Target: "c"},
+		{Source: "c", Target: "t", Sourcehandle: TRUE},
+		{Source: "c", Target: "f", Sourcehandle: FALSE},
+	}}}
+	wp.ContinueWorkflow(context.Background(), "0", variablesOf(variables))
+	branch, _ := wp.getVariable("branch")
+	return branch.String()
+}
+
+func TestConditionKeepsStringsAsStrings(t *testing.T) {
+	variables := map[string]interface{}{
+		"zip":     "01234",
+		"code":    "200",
+		"count":   "10",
+		"total":   250.0,
+		"allowed": []interface{}{"200", "201"},
+		"payload": `1 == 1 || "x`,
+	}
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{`"{{.zip}}" == "1234"`, FALSE},
+		{`"{{.zip}}" == "01234"`, TRUE},
+		{`"{{.code}}" == "2e2"`, FALSE},
+		{`"{{.code}}" in ["200","201"]`, TRUE},
+		{`code in allowed`, TRUE},
+		{`{{.code}} in allowed`, FALSE},
+		{`{{.count}} > 5`, TRUE},
+		{`{{.total}} > 100 && "{{.code}}" == "200"`, TRUE},
+		{`"{{.payload}}" == "x"`, FALSE},
+		{`{{.payload}} == "x"`, FALSE},
+		{`code == 200`, ""},
+	}
+	for _, test := range tests {
+		if got := runCondition(t, test.expression, variables); got != test.want {
+			t.Errorf("%s took %q, want %q", test.expression, got, test.want)
+		}
+	}
+}
+
+func TestRunsCallTheirOwnFunctions(t *testing.T) {
+	functions := m.NewFunctions()
+	err := functions.Register("isVip", m.Function{
+		Args: []m.ArgType{m.StringArg},
+		Call: func(args []interface{}) (interface{}, error) {
+			return args[0] == "gold", nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, run := range []struct {
+		functions *m.Functions
+		want      string
+	}{{functions, TRUE}, {nil, ""}} {
+		wp := &WorkflowProcessor{ID: t.Name(), Functions: run.functions, Workflow: &proto.Workflow{Id: t.Name(), Nodes: []*proto.Node{
+			{Id: "0", Nodetype: "defaultnode"},
+			{Id: "c", Nodetype: "condition", Data: &proto.NodeData{Expression: ptr("isVip(tier)")}},
+			{Id: "t", Nodetype: "text", Data: &proto.NodeData{Message: ptr(TRUE), Variable: ptr("branch")}},
+		}, Edges: []*proto.Edge{
+			{Source: "0", Target: "c"},
+			{Source: "c", Target: "t", Sourcehandle: TRUE},
+		}}}
+		wp.ContinueWorkflow(context.Background(), "0", variablesOf(map[string]interface{}{"tier": "gold"}))
+		if branch, _ := wp.getVariable("branch"); branch.String() != run.want {
+			t.Errorf("with functions %v the condition took %q, want %q", run.functions != nil, branch.String(), run.want)
+		}
+	}
+}
@@ -1,11 +1,14 @@
 package workflow
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	"github.com/raenardcruz/floowsynk/Server/value"
 )
 
 const (
@@ -29,6 +32,13 @@ const (
 	replaceType     = "replace"
 	findAllType     = "findAll"
 	subprocessType  = "subprocess"
+	joinType        = "join"
+	delayType       = "delay"
+	approvalType    = "approval"
+	switchType      = "switch"
+	breakType       = "break"
+	continueType    = "continue"
+	imageType       = "image"
 )
 
 const (
@@ -37,29 +47,218 @@ const (
 	OUTPUT  = "output"
 	TRUE    = "True"
 	FALSE   = "False"
+	ERROR   = "error"
+	DEFAULT = "default"
+	BODY    = "body"
+	DONE    = "done"
 )
 
-func (wp *WorkflowProcessor) StartWorkflow() (err error) {
+// Fields of the ERROR variable a failed node sets before following its error
+// handle, read as {{.error.message}} and {{.error.nodeId}}.
+const (
+	ERROR_MESSAGE = "message"
+	ERROR_NODE_ID = "nodeId"
+)
+
+// Approval node source handles and the variables describing the decision.
+const (
+	APPROVED           = "approved"
+	REJECTED           = "rejected"
+	TIMEOUT            = "timeout"
+	APPROVAL_DECISION  = "approval.decision"
+	APPROVAL_DECIDEDBY = "approval.decidedBy"
+	APPROVAL_COMMENT   = "approval.comment"
+)
+
+// SUBPROCESS_RUNID holds the run id of the last subprocess a run called.
+const SUBPROCESS_RUNID = "subprocess.runId"
+
+// Values accepted in RetryPolicy.retryOn besides exact status codes such as "429".
+const (
+	retryOnAny     = "any"
+	retryOnNetwork = "network"
+	retryOn4xx     = "4xx"
+	retryOn5xx     = "5xx"
+)
+
+const defaultRetryMultiplier = 2
+
+// defaultRequestTimeout bounds API calls made without a node or run deadline.
+const defaultRequestTimeout = 5 * time.Minute
+
+const (
+	delayDuration = "duration"
+	delayUntil    = "until"
+)
+
+// minParkDelay is the shortest wait that parks a durable run; shorter delays
+// sleep in place. Runs that cannot park, such as QuickRun and DebugRun, and
+// the scopes of parallel foreach items and subprocesses, reject longer ones.
+const minParkDelay = time.Minute
+
+const (
+	joinAll   = "all"
+	joinCount = "count"
+	joinFirst = "first"
+	mergeList = "list"
+	mergeMap  = "map"
+)
+
+func (wp *WorkflowProcessor) StartWorkflow(ctx context.Context) error {
+	if wp.Durable {
+		if err := wp.createDurableRun(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return wp.execute(ctx, make(map[string]value.Value))
+}
+
+// ContinueWorkflow starts the run at nodeId with variables instead of at the
+// trigger, so the steps before nodeId are not executed again.
+func (wp *WorkflowProcessor) ContinueWorkflow(ctx context.Context, nodeId string, variables map[string]value.Value) error {
+	if _, ok := getNodeById(wp.Workflow.Nodes, nodeId); !ok {
+		return fmt.Errorf("node %s not found in workflow %s", nodeId, wp.Workflow.Id)
+	}
+	wp.StartNodeID = nodeId
+	if wp.Durable {
+		if err := wp.createDurableRun(ctx, variables); err != nil {
+			return err
+		}
+	}
+	if wp.ParentID != "" {
+		wp.UpdateStatus(&proto.Node{Id: nodeId}, proto.NodeStatus_INFO, nil, fmt.Sprintf("Resuming run %s from node %s", wp.ParentID, nodeId), false)
+	}
+	return wp.execute(ctx, variables)
+}
+
+// ResumeWorkflow continues a durable run from its checkpoints. The run is
+// traversed again from the trigger; nodes that already completed are skipped
+// and their recorded variables restored, which brings loops and branches back
+// to where the run stopped.
+func (wp *WorkflowProcessor) ResumeWorkflow(ctx context.Context) error {
+	checkpoints, err := wp.DBcon.GetCheckpoints(wp.ID)
+	if err != nil {
+		return err
+	}
+	wp.loadJournal(checkpoints)
+	if history, err := wp.DBcon.GetReplayDataGroupedByProcessID(wp.ID); err == nil && len(history) > 0 {
+		if next := int32(history[len(history)-1].ProcessSequence) + 1; next > wp.Step {
+			wp.Step = next
+		}
+	}
+	log.Default().Printf("Resuming run %s from %d checkpoints", wp.ID, len(checkpoints))
+	return wp.execute(ctx, wp.ProcessVariables)
+}
+
+func (wp *WorkflowProcessor) execute(ctx context.Context, variables map[string]value.Value) (err error) {
 	start := time.Now()
-	wp.ProcessVariables = make(map[string]interface{})
-	wp.ProcessVariables[INPUT] = ""
-	wp.ProcessVariables[OUTPUT] = ""
-	wp.Process("0")
+	ctx, release := wp.registerRun(ctx)
+	defer release()
+	if wp.Durable {
+		defer wp.holdRunLease(ctx)()
+	}
+	if wp.Debug {
+		defer wp.registerDebug()()
+	}
+	timeout := time.Duration(wp.Workflow.GetTimeoutMs()) * time.Millisecond
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if variables == nil {
+		variables = make(map[string]value.Value)
+	}
+	for _, name := range []string{INPUT, OUTPUT} {
+		if _, ok := variables[name]; !ok {
+			variables[name] = value.String("")
+		}
+	}
+	wp.ProcessVariables = variables
+	err = wp.Process(ctx, wp.startNodeId())
 	duration := time.Since(start)
+	var parked *RunParkedError
+	if errors.As(err, &parked) {
+		wp.UpdateRunStatus(proto.NodeStatus_WAITING, fmt.Sprintf("Workflow waiting: %v", parked))
+		wp.parkDurableRun(parked.WakeAt)
+		log.Default().Printf("Workflow %s parked after %s: %v", wp.Workflow.Id, duration, parked)
+		return nil
+	}
+	if err != nil {
+		status := proto.NodeStatus_FAILED
+		message := fmt.Sprintf("Workflow failed: %v", err)
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			message = fmt.Sprintf("Workflow timed out after %s", timeout)
+		case errors.Is(ctx.Err(), context.Canceled):
+			status = proto.NodeStatus_CANCELLED
+			message = fmt.Sprintf("Workflow cancelled: %v", context.Cause(ctx))
+		}
+		wp.UpdateRunStatus(status, message)
+		wp.finishDurableRun(status)
+		log.Default().Printf("Workflow %s failed after %s: %v", wp.Workflow.Id, duration, err)
+		return err
+	}
+	wp.UpdateRunStatus(proto.NodeStatus_COMPLETED, "Workflow completed")
+	wp.finishDurableRun(proto.NodeStatus_COMPLETED)
 	log.Default().Printf("Workflow %s completed in %s", wp.Workflow.Id, duration)
 	return nil
 }
 
-func (wp *WorkflowProcessor) Process(nodeId string) (err error) {
-	wp.ProcessVariables[INPUT] = wp.ProcessVariables[OUTPUT]
-	sourceHandle := ""
+// startNodeId returns the node the run starts at: StartNodeID when set,
+// otherwise the trigger node, or "0" for a workflow without one.
+func (wp *WorkflowProcessor) startNodeId() string {
+	if wp.StartNodeID != "" {
+		return wp.StartNodeID
+	}
+	for _, node := range wp.Workflow.Nodes {
+		if triggerTypes[node.Nodetype] {
+			return node.Id
+		}
+	}
+	return "0"
+}
+
+func (wp *WorkflowProcessor) Process(ctx context.Context, nodeId string) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	occurrence := wp.nextOccurrence(nodeId)
+	if checkpoint, ok := wp.journaled(nodeId, occurrence); ok {
+		wp.restoreCheckpoint(checkpoint)
+		return wp.nextProcess(ctx, nodeId, checkpoint.SourceHandle)
+	}
 	node, exist := getNodeById(wp.Workflow.Nodes, nodeId)
-	wp.UpdateStatus(node, proto.NodeStatus_RUNNING, nil, "", false)
 	if !exist {
-		return nil
+		return fmt.Errorf("node %s not found in workflow %s", nodeId, wp.Workflow.Id)
 	}
+	processFunc, ok := wp.nodeProcessors()[node.Nodetype]
+	if !ok {
+		return fmt.Errorf("unknown node type %q of node %s", node.Nodetype, nodeId)
+	}
+	output, _ := wp.getVariable(OUTPUT)
+	wp.setVariable(INPUT, output)
+	if err := wp.debugPause(ctx, node); err != nil {
+		return err
+	}
+	sourceHandle := ""
+	wp.UpdateStatus(node, proto.NodeStatus_RUNNING, nil, "", false)
+
+	if sourceHandle, err = wp.runWithRetry(ctx, node, processFunc); err != nil {
+		var parked *RunParkedError
+		if errors.As(err, &parked) && parked.Done && parked.NodeID == node.Id {
+			wp.saveCheckpoint(node, occurrence, sourceHandle)
+		}
+		return wp.handleNodeError(ctx, node, err)
+	}
+	wp.saveCheckpoint(node, occurrence, sourceHandle)
+
+	return wp.nextProcess(ctx, nodeId, sourceHandle)
+}
 
-	nodeProcessors := map[string]func(*proto.Node) (string, error){
+// nodeProcessors maps each node type to the method that runs it.
+func (wp *WorkflowProcessor) nodeProcessors() map[string]nodeProcessor {
+	return map[string]nodeProcessor{
 		defaultnodeType: wp.DefaultNodeProcess,
 		intervalType:    wp.DefaultNodeProcess,
 		webhookType:     wp.DefaultNodeProcess,
@@ -80,27 +279,34 @@ func (wp *WorkflowProcessor) Process(nodeId string) (err error) {
 		replaceType:     wp.ReplaceNodeProcess,
 		findAllType:     wp.FindAllNodeProcess,
 		subprocessType:  wp.SubProcessNodeProcess,
+		joinType:        wp.JoinNodeProcess,
+		delayType:       wp.DelayNodeProcess,
+		approvalType:    wp.ApprovalNodeProcess,
+		switchType:      wp.SwitchNodeProcess,
+		breakType:       wp.BreakNodeProcess,
+		continueType:    wp.ContinueNodeProcess,
+		imageType:       wp.ImageNodeProcess,
 	}
+}
 
-	processFunc, ok := nodeProcessors[node.Nodetype]
-	if !ok {
-		return errors.New("unknown node type")
+// handleNodeError routes a failed node to its error handle when one is
+// connected, exposing the failure through variables. Without an error edge
+// the error fails the run.
+func (wp *WorkflowProcessor) handleNodeError(ctx context.Context, node *proto.Node, err error) error {
+	if isControlSignal(err) {
+		return err
 	}
-
-	if node.Nodetype == conditionType {
-		if sourceHandle, err = processFunc(node); err != nil {
-			return err
-		}
-	} else {
-		if sourceHandle, err = processFunc(node); err != nil {
-			return err
-		}
+	if _, ok := wp.GetNextNodes(node.Id, ERROR); !ok || ctx.Err() != nil {
+		return err
 	}
-
-	wp.nextProcess(nodeId, sourceHandle)
-	return nil
+	wp.setVariable(ERROR, value.Map(map[string]value.Value{
+		ERROR_MESSAGE: value.String(err.Error()),
+		ERROR_NODE_ID: value.String(node.Id),
+	}))
+	wp.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("Error routed to error handle: %v", err), true)
+	return wp.nextProcess(ctx, node.Id, ERROR)
 }
 
-func (wp *WorkflowProcessor) ConditionNodeProcessWrapper(node *proto.Node) (string, error) {
-	return wp.ConditionNodeProcess(node)
+func (wp *WorkflowProcessor) ConditionNodeProcessWrapper(ctx context.Context, node *proto.Node) (string, error) {
+	return wp.ConditionNodeProcess(ctx, node)
 }
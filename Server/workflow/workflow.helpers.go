@@ -1,49 +1,67 @@
 package workflow
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html"
-	"html/template"
+	mrand "math/rand/v2"
 	"net/http"
+	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/raenardcruz/floowsynk/Broker"
 	"github.com/raenardcruz/floowsynk/Broker/kafka"
 	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
 	DB "github.com/raenardcruz/floowsynk/Database"
+	"github.com/raenardcruz/floowsynk/Server/value"
 )
 
 func (wp *WorkflowProcessor) UpdateStatus(node *proto.Node, status proto.NodeStatus, output interface{}, message string, includeReplayData bool) {
 	if (output != nil) && (status == proto.NodeStatus_COMPLETED || status == proto.NodeStatus_FAILED) {
-		switch v := output.(type) {
-		case string:
-			wp.ProcessVariables[OUTPUT] = v
-		case float64, int, int64, bool:
-			wp.ProcessVariables[OUTPUT] = fmt.Sprintf("%v", v)
-		default:
-			outputBytes, err := json.MarshalIndent(output, "", "  ")
-			if err != nil {
-				wp.ProcessVariables[OUTPUT] = fmt.Sprintf("error marshaling output: %v", err)
-			} else {
-				wp.ProcessVariables[OUTPUT] = string(outputBytes)
-			}
-		}
+		wp.setVariable(OUTPUT, output)
 	}
 
+	// Subprocess steps are recorded under the run that called them, with
+	// node ids qualified by the calling subprocess node.
+	emitter := wp.emitRoot()
+	nodeId := node.Id
+	if nodeId != "" {
+		nodeId = wp.nodePrefix + nodeId
+	}
 	res := &proto.ReplayData{
-		NodeId:  node.Id,
-		Status:  status,
-		Message: message,
+		NodeId:    nodeId,
+		Status:    status,
+		Message:   message,
+		ProcessId: emitter.ID,
 	}
+	var snapshot map[string]value.Value
 	if includeReplayData {
-		res.Variables = wp.getVariableMapString()
+		snapshot = wp.snapshotVariables()
+		res.Variables = variableMapString(snapshot)
+		typed, err := value.Struct(snapshot)
+		if err != nil {
+			fmt.Printf("Error converting variables of ProcessID %s: %v\n", wp.ID, err)
+		}
+		res.TypedVariables = typed
 		res.Data = node.Data
 	}
 
+	// Parallel branches share the stream and the sequence counter, so a
+	// record is numbered and sent in one step to keep history ordered.
+	emitter.emitMu.Lock()
+	defer emitter.emitMu.Unlock()
+	sequence := emitter.Step
+	emitter.Step = emitter.Step + 1
+
 	if wp.Stream != nil {
 		wp.Stream.SendMsg(res)
 	}
@@ -59,15 +77,22 @@ func (wp *WorkflowProcessor) UpdateStatus(node *proto.Node, status proto.NodeSta
 		fmt.Printf("Error marshaling RunWorkflowResponse: %v\n", err)
 		return
 	}
+	typedVariables, err := json.Marshal(snapshot)
+	if err != nil {
+		fmt.Printf("Error marshaling RunWorkflowResponse: %v\n", err)
+		return
+	}
 	dbRD := DB.ReplayData{
-		ProcessID:       wp.ID,
-		WorkflowID:      wp.Workflow.Id,
+		ProcessID:       emitter.ID,
+		WorkflowID:      emitter.Workflow.Id,
 		NodeID:          res.NodeId,
 		Data:            data,
 		Variables:       variables,
+		TypedVariables:  typedVariables,
 		Status:          int32(res.Status),
 		Message:         res.Message,
-		ProcessSequence: int(wp.Step),
+		ProcessSequence: int(sequence),
+		Scoped:          wp.root != nil,
 	}
 	rdBytes, err := json.Marshal(dbRD)
 	if err != nil {
@@ -75,54 +100,341 @@ func (wp *WorkflowProcessor) UpdateStatus(node *proto.Node, status proto.NodeSta
 		return
 	}
 	if wp.Producer != nil && *wp.Producer != nil {
-		kafka.SendMessage(*wp.Producer, Broker.WORKFLOW_REPLAY_DATA, wp.ID, string(rdBytes))
+		kafka.SendMessage(*wp.Producer, Broker.WORKFLOW_REPLAY_DATA, emitter.ID, string(rdBytes))
 	} else {
-		fmt.Printf("Warning: Kafka producer is not initialized, skipping log sending for ProcessID %s, NodeID %s\n", wp.ID, res.NodeId)
+		fmt.Printf("Warning: Kafka producer is not initialized, skipping log sending for ProcessID %s, NodeID %s\n", emitter.ID, res.NodeId)
 	}
 }
 
-func (wp *WorkflowProcessor) getVariableMapString() map[string]string {
-	variableMap := make(map[string]string)
-	for k, v := range wp.ProcessVariables {
-		switch value := v.(type) {
-		case string:
-			variableMap[k] = value
-		case float64, int, int64, bool:
-			variableMap[k] = fmt.Sprintf("%v", value)
-		default:
-			outputBytes, err := json.Marshal(value)
-			if err != nil {
-				variableMap[k] = fmt.Sprintf("error marshaling value: %v", err)
-			} else {
-				variableMap[k] = string(outputBytes)
-			}
+func (wp *WorkflowProcessor) emitRoot() *WorkflowProcessor {
+	if wp.root != nil {
+		return wp.root
+	}
+	return wp
+}
+
+// newScope returns a processor for the same run with its own variables. Its
+// steps are numbered and emitted together with the run's other steps.
+func (wp *WorkflowProcessor) newScope(variables map[string]value.Value) *WorkflowProcessor {
+	return &WorkflowProcessor{
+		ID:               wp.ID,
+		Stream:           wp.Stream,
+		Workflow:         wp.Workflow,
+		ProcessVariables: variables,
+		DBcon:            wp.DBcon,
+		Producer:         wp.Producer,
+		root:             wp.emitRoot(),
+		nodePrefix:       wp.nodePrefix,
+		depth:            wp.depth,
+	}
+}
+
+// newBranch returns a processor for one branch of a parallel fan-out. It starts
+// with a copy of variables, so its input and output are its own, and shares
+// the joins and journal of wp.
+func (wp *WorkflowProcessor) newBranch(variables map[string]value.Value) *WorkflowProcessor {
+	copied := make(map[string]value.Value, len(variables))
+	for k, v := range variables {
+		copied[k] = v
+	}
+	branch := wp.newScope(copied)
+	branch.Durable = wp.Durable
+	branch.branchOf = wp.owner()
+	branch.forkBase = wp.forkBase
+	if branch.forkBase == nil {
+		branch.forkBase = variables
+	}
+	return branch
+}
+
+// branchVariables returns the variables a parallel branch set or changed
+// since the fan-out it belongs to, other than its input and output.
+func (wp *WorkflowProcessor) branchVariables() map[string]value.Value {
+	if wp.forkBase == nil {
+		return nil
+	}
+	changed := make(map[string]value.Value)
+	for k, v := range wp.snapshotVariables() {
+		if k == INPUT || k == OUTPUT {
+			continue
+		}
+		if old, ok := wp.forkBase[k]; ok && reflect.DeepEqual(old, v) {
+			continue
+		}
+		changed[k] = v
+	}
+	return changed
+}
+
+// owner returns the processor that keeps the joins and journal of wp: wp
+// itself unless it is a parallel branch.
+func (wp *WorkflowProcessor) owner() *WorkflowProcessor {
+	if wp.branchOf != nil {
+		return wp.branchOf
+	}
+	return wp
+}
+
+// mergeBranch copies the variables a finished branch set or changed since
+// base back into wp. The branch's input stays with the branch.
+func (wp *WorkflowProcessor) mergeBranch(base map[string]value.Value, branch *WorkflowProcessor) {
+	for k, v := range branch.snapshotVariables() {
+		if k == INPUT {
+			continue
 		}
+		if old, ok := base[k]; ok && reflect.DeepEqual(old, v) {
+			continue
+		}
+		wp.setVariable(k, v)
+	}
+	wp.passedJoin = wp.passedJoin || branch.passedJoin
+}
+
+// newSubprocess returns a processor that runs workflow as a child of this run,
+// under its own run id and with only variables in scope. Its steps are
+// recorded with the calling run's steps, under node.
+func (wp *WorkflowProcessor) newSubprocess(node *proto.Node, workflow *proto.Workflow, variables map[string]value.Value) *WorkflowProcessor {
+	return &WorkflowProcessor{
+		ID:               uuid.NewString(),
+		Stream:           wp.Stream,
+		Workflow:         workflow,
+		ProcessVariables: variables,
+		DBcon:            wp.DBcon,
+		Producer:         wp.Producer,
+		root:             wp.emitRoot(),
+		nodePrefix:       wp.nodePrefix + node.Id + "/",
+		depth:            wp.depth + 1,
+	}
+}
+
+// bindingPattern matches a binding that names a single variable, {{.name}}.
+var bindingPattern = regexp.MustCompile(`^\{\{\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}$`)
+
+// evaluateBinding returns the variable a binding names with its kind intact,
+// and renders any other binding as a template.
+func (wp *WorkflowProcessor) evaluateBinding(binding string) value.Value {
+	if match := bindingPattern.FindStringSubmatch(strings.TrimSpace(binding)); match != nil {
+		if v, ok := wp.getVariable(match[1]); ok {
+			return v
+		}
+	}
+	return value.String(wp.populateTemplate(binding, nil))
+}
+
+// UpdateRunStatus records the terminal status of the whole run. Run-level
+// records carry an empty node id.
+func (wp *WorkflowProcessor) UpdateRunStatus(status proto.NodeStatus, message string) {
+	wp.UpdateStatus(&proto.Node{}, status, nil, message, true)
+}
+
+// variableMapString flattens variables to the text form kept in
+// ReplayData.variables for the UI.
+func variableMapString(variables map[string]value.Value) map[string]string {
+	variableMap := make(map[string]string, len(variables))
+	for k, v := range variables {
+		variableMap[k] = v.String()
 	}
 	return variableMap
 }
 
-func (wp *WorkflowProcessor) setVariable(varName string, value interface{}) error {
+func (wp *WorkflowProcessor) setVariable(varName string, v interface{}) error {
 	if varName == "" {
 		return fmt.Errorf("variable name is empty")
 	}
-	wp.ProcessVariables[varName] = value
+	wp.varsMu.Lock()
+	defer wp.varsMu.Unlock()
+	wp.ProcessVariables[varName] = value.From(v)
 	return nil
 }
 
-func (wp *WorkflowProcessor) nextProcess(nodeId string, sourceHandle string) error {
-	wp.Step = wp.Step + 1
+func (wp *WorkflowProcessor) getVariable(varName string) (value.Value, bool) {
+	wp.varsMu.RLock()
+	defer wp.varsMu.RUnlock()
+	v, ok := wp.ProcessVariables[varName]
+	return v, ok
+}
+
+func (wp *WorkflowProcessor) snapshotVariables() map[string]value.Value {
+	wp.varsMu.RLock()
+	defer wp.varsMu.RUnlock()
+	snapshot := make(map[string]value.Value, len(wp.ProcessVariables))
+	for k, v := range wp.ProcessVariables {
+		snapshot[k] = v
+	}
+	return snapshot
+}
+
+func (wp *WorkflowProcessor) nextProcess(ctx context.Context, nodeId string, sourceHandle string) error {
 	targets, ok := wp.GetNextNodes(nodeId, sourceHandle)
 	if !ok {
 		return nil
 	}
+	if node, exist := getNodeById(wp.Workflow.Nodes, nodeId); exist && node.Data.GetParallel() && len(targets) > 1 {
+		return wp.parallelProcess(ctx, nodeId, targets, concurrencyLimit(node))
+	}
 	for _, target := range targets {
-		if err := wp.Process(target.Id); err != nil {
+		if err := wp.processTarget(ctx, nodeId, target); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// parallelProcess runs each target branch on its own goroutine, with at most
+// limit branches in flight, and returns the first error reported by a branch.
+// A failing branch cancels its siblings. Each branch runs in its own scope;
+// afterwards the variables they set are merged back in target order, except
+// that branches which continued past a join come last, as their output is
+// the merged one.
+func (wp *WorkflowProcessor) parallelProcess(ctx context.Context, sourceId string, targets []*proto.Node, limit int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	base := wp.snapshotVariables()
+	branches := make([]*WorkflowProcessor, len(targets))
+	sem := make(chan struct{}, limit)
+	errs := make(chan error, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		branches[i] = wp.newBranch(base)
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(branch *WorkflowProcessor, target *proto.Node) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := branch.processTarget(ctx, sourceId, target); err != nil {
+				errs <- err
+				cancel()
+			}
+		}(branches[i], target)
+	}
+	wg.Wait()
+	for _, joined := range []bool{false, true} {
+		for _, branch := range branches {
+			if branch.passedJoin == joined {
+				wp.mergeBranch(base, branch)
+			}
+		}
+	}
+	close(errs)
+	return <-errs
+}
+
+// processTarget runs target as the step after sourceId. A join node only runs
+// for the arrival that completes it; the other arrivals stop at the join.
+func (wp *WorkflowProcessor) processTarget(ctx context.Context, sourceId string, target *proto.Node) error {
+	if target.Nodetype == joinType {
+		output, _ := wp.getVariable(OUTPUT)
+		if !wp.arriveAtJoin(sourceId, target, output, wp.branchVariables()) {
+			return nil
+		}
+		wp.passedJoin = true
+	}
+	return wp.Process(ctx, target.Id)
+}
+
+// arriveAtJoin records output and variables as the result of the branch from
+// sourceId at the join node and reports whether this arrival satisfies the
+// join. Once every upstream branch has arrived the join resets, so it can
+// fire again on the next loop iteration.
+func (wp *WorkflowProcessor) arriveAtJoin(sourceId string, node *proto.Node, output value.Value, variables map[string]value.Value) bool {
+	total := len(wp.getSourceNodeIds(node.Id))
+	required := joinRequired(node, total)
+
+	owner := wp.owner()
+	owner.joinMu.Lock()
+	if owner.joins == nil {
+		owner.joins = make(map[string]*joinState)
+	}
+	state, ok := owner.joins[node.Id]
+	if !ok {
+		state = &joinState{}
+		owner.joins[node.Id] = state
+	}
+	replaced := false
+	for i, arrival := range state.arrivals {
+		if arrival.Source == sourceId {
+			state.arrivals[i].Output = output
+			state.arrivals[i].Variables = variables
+			replaced = true
+		}
+	}
+	if !replaced {
+		state.arrivals = append(state.arrivals, joinArrival{Source: sourceId, Output: output, Variables: variables})
+	}
+	arrived := len(state.arrivals)
+	alreadyFired := state.fired
+	fire := !state.fired && arrived >= required
+	if fire {
+		state.fired = true
+		state.ready = append(state.ready, append([]joinArrival(nil), state.arrivals...))
+	}
+	if arrived >= total {
+		state.arrivals = nil
+		state.fired = false
+	}
+	owner.joinMu.Unlock()
+
+	if alreadyFired {
+		wp.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("Branch from %s reached join after it continued", sourceId), true)
+	} else if !fire {
+		wp.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("Join waiting for branches: %d of %d arrived", arrived, required), true)
+	}
+	return fire
+}
+
+// takeJoinArrivals pops the oldest set of merged arrivals for a join node.
+func (wp *WorkflowProcessor) takeJoinArrivals(nodeId string) []joinArrival {
+	owner := wp.owner()
+	owner.joinMu.Lock()
+	defer owner.joinMu.Unlock()
+	state, ok := owner.joins[nodeId]
+	if !ok || len(state.ready) == 0 {
+		return nil
+	}
+	arrivals := state.ready[0]
+	state.ready = state.ready[1:]
+	return arrivals
+}
+
+func joinRequired(node *proto.Node, total int) int {
+	required := total
+	switch node.Data.GetMode() {
+	case joinFirst:
+		required = 1
+	case joinCount:
+		required = int(node.Data.GetRequired())
+	}
+	if required > total {
+		required = total
+	}
+	if required < 1 {
+		required = 1
+	}
+	return required
+}
+
+func (wp *WorkflowProcessor) getSourceNodeIds(nodeId string) []string {
+	sources := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, edge := range wp.Workflow.Edges {
+		if edge.Target == nodeId && !seen[edge.Source] {
+			seen[edge.Source] = true
+			sources = append(sources, edge.Source)
+		}
+	}
+	return sources
+}
+
+func concurrencyLimit(node *proto.Node) int {
+	if limit := int(node.Data.GetConcurrency()); limit > 0 {
+		return limit
+	}
+	if DB.AppConfig.Max_Concurrency > 0 {
+		return DB.AppConfig.Max_Concurrency
+	}
+	return 1
+}
+
 func getNodeById(n []*proto.Node, id string) (*proto.Node, bool) {
 	for _, node := range n {
 		if node.Id == id {
@@ -136,7 +448,7 @@ func (wp *WorkflowProcessor) GetNextNodes(nodeId string, sourceHandle string) ([
 	edges := wp.Workflow.Edges
 	targets := make([]*proto.Node, 0)
 	for _, edge := range edges {
-		if edge.Source == nodeId && (sourceHandle == "" || edge.Sourcehandle == sourceHandle) {
+		if edge.Source == nodeId && ((sourceHandle == "" && edge.Sourcehandle != ERROR) || edge.Sourcehandle == sourceHandle || (sourceHandle == BODY && isLegacyBodyHandle(edge.Sourcehandle))) {
 			node, ok := getNodeById(wp.Workflow.Nodes, edge.Target)
 			if !ok {
 				return nil, false
@@ -147,23 +459,106 @@ func (wp *WorkflowProcessor) GetNextNodes(nodeId string, sourceHandle string) ([
 	return targets, len(targets) > 0
 }
 
-func (wp *WorkflowProcessor) populateTemplate(text string, data map[string]interface{}) string {
-	joinedMap := make(map[string]interface{})
-	for k, v := range wp.ProcessVariables {
-		joinedMap[k] = v
+// isLegacyBodyHandle reports whether a loop edge was drawn before loops had
+// body and done handles, when every outgoing edge was the loop body.
+func isLegacyBodyHandle(handle string) bool {
+	return handle == "" || handle == OUTPUT
+}
+
+// setLoopResults hands the output of every iteration to the done branch, and
+// to the node's variable when one is set.
+func (wp *WorkflowProcessor) setLoopResults(node *proto.Node, results []value.Value) {
+	wp.setVariable(OUTPUT, value.List(results))
+	if variable := node.Data.GetVariable(); variable != "" {
+		wp.setVariable(variable, value.List(results))
 	}
+}
+
+func (wp *WorkflowProcessor) populateTemplate(text string, data map[string]value.Value) string {
+	return wp.executeTemplate(text, data, false)
+}
+
+// renderTemplate renders a template of node, with text/template when the node
+// asks for plain text so that values such as JSON are not escaped.
+func (wp *WorkflowProcessor) renderTemplate(node *proto.Node, text string, data map[string]value.Value) string {
+	return wp.executeTemplate(text, data, node.Data.GetPlainText())
+}
+
+func (wp *WorkflowProcessor) executeTemplate(text string, data map[string]value.Value, plain bool) string {
+	joinedMap := templateData(wp.snapshotVariables())
 	for k, v := range data {
-		joinedMap[k] = v
+		joinedMap[k] = templateValue(v)
+	}
+	return wp.executeWith(text, joinedMap, plain)
+}
+
+// templateData converts variables to the data templates execute against.
+func templateData(variables map[string]value.Value) map[string]interface{} {
+	data := make(map[string]interface{}, len(variables))
+	for k, v := range variables {
+		data[k] = templateValue(v)
 	}
-	tmpl, err := template.New("template").Option("missingkey=zero").Parse(text)
+	return data
+}
+
+// executeWith renders text against data converted by templateData.
+func (wp *WorkflowProcessor) executeWith(text string, data map[string]interface{}, plain bool) string {
+	tmpl, err := wp.parseTemplate(text, plain)
 	if err != nil {
 		return text
 	}
 	var builder strings.Builder
-	tmpl.Execute(&builder, joinedMap)
+	tmpl.Execute(&builder, data)
+	if plain {
+		return builder.String()
+	}
 	return html.UnescapeString(builder.String())
 }
 
+// templateList and templateMap let templates index and range over lists and
+// maps while {{.name}} on its own still prints them as JSON.
+type templateList []interface{}
+type templateMap map[string]interface{}
+
+func (l templateList) String() string { return value.From([]interface{}(l)).String() }
+func (m templateMap) String() string  { return value.From(map[string]interface{}(m)).String() }
+func (kv KeyValue) String() string    { return value.From(kv).String() }
+
+func templateValue(v value.Value) interface{} {
+	if items, ok := v.AsList(); ok {
+		list := make(templateList, len(items))
+		for i, item := range items {
+			list[i] = templateValue(item)
+		}
+		return list
+	}
+	if fields, ok := v.AsMap(); ok {
+		if kv, ok := keyValueItem(fields); ok {
+			return kv
+		}
+		m := make(templateMap, len(fields))
+		for k, item := range fields {
+			m[k] = templateValue(item)
+		}
+		return m
+	}
+	return v.Native()
+}
+
+// keyValueItem recognises the items of a key-value list node, so templates
+// can keep reading them as {{.output.Key}} and {{.output.Value}}.
+func keyValueItem(fields map[string]value.Value) (KeyValue, bool) {
+	if len(fields) != 2 {
+		return KeyValue{}, false
+	}
+	key, keyOk := fields["key"].AsString()
+	val, valOk := fields["value"].AsString()
+	if !keyOk || !valOk {
+		return KeyValue{}, false
+	}
+	return KeyValue{Key: key, Value: val}, true
+}
+
 func generateGUID() string {
 	bytes := make([]byte, 16)
 	if _, err := rand.Read(bytes); err != nil {
@@ -172,8 +567,14 @@ func generateGUID() string {
 	return hex.EncodeToString(bytes)
 }
 
-func (wp *WorkflowProcessor) makeRequest(url string, method string, headers []*proto.KeyValue, payload interface{}) (body interface{}, err error) {
+// makeRequest calls url and returns the decoded JSON response. A 4xx or 5xx
+// response is only an error when policy retries that status; otherwise the
+// node sees the body and api.status as for any other response.
+func (wp *WorkflowProcessor) makeRequest(ctx context.Context, url string, method string, headers []*proto.KeyValue, payload interface{}, policy *proto.RetryPolicy) (body interface{}, err error) {
 	client := &http.Client{}
+	if _, ok := ctx.Deadline(); !ok {
+		client.Timeout = defaultRequestTimeout
+	}
 	var req *http.Request
 
 	if payload != nil {
@@ -181,13 +582,13 @@ func (wp *WorkflowProcessor) makeRequest(url string, method string, headers []*p
 		if err != nil {
 			return nil, err
 		}
-		req, err = http.NewRequest(method, url, strings.NewReader(string(payloadBytes)))
+		req, err = http.NewRequestWithContext(ctx, method, url, strings.NewReader(string(payloadBytes)))
 		if err != nil {
 			return nil, err
 		}
 		req.Header.Set("Content-Type", "application/json")
 	} else {
-		req, err = http.NewRequest(method, url, nil)
+		req, err = http.NewRequestWithContext(ctx, method, url, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -198,15 +599,22 @@ func (wp *WorkflowProcessor) makeRequest(url string, method string, headers []*p
 	}
 
 	resp, err := client.Do(req)
-	wp.setVariable("api.status", resp.StatusCode)
-	wp.setVariable("api.headers", resp.Header)
-	wp.setVariable("api.length", resp.ContentLength)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
+	wp.setVariable("api.status", resp.StatusCode)
+	wp.setVariable("api.headers", resp.Header)
+	wp.setVariable("api.length", resp.ContentLength)
 
 	err = json.NewDecoder(resp.Body).Decode(&body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		statusErr := &HTTPStatusError{StatusCode: resp.StatusCode, Status: resp.Status}
+		if policy != nil && shouldRetry(policy, statusErr) {
+			wp.setVariable("api.body", body)
+			return nil, statusErr
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -215,20 +623,182 @@ func (wp *WorkflowProcessor) makeRequest(url string, method string, headers []*p
 	return body, nil
 }
 
+// runWithRetry runs processFunc and, when the node has a retry policy,
+// retries failures that match policy.retryOn with exponential backoff and
+// jitter.
+func (wp *WorkflowProcessor) runWithRetry(ctx context.Context, node *proto.Node, processFunc nodeProcessor) (string, error) {
+	policy := node.Data.GetRetry()
+	attempts := int(policy.GetMaxAttempts())
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := time.Duration(policy.GetInitialDelayMs()) * time.Millisecond
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			wait := retryJitter(delay)
+			wp.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("Attempt %d of %d after %s backoff: %v", attempt, attempts, wait, lastErr), true)
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+			delay = nextRetryDelay(policy, delay)
+		} else if attempts > 1 {
+			wp.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("Attempt %d of %d", attempt, attempts), true)
+		}
+		sourceHandle, err := wp.runAttempt(ctx, node, processFunc)
+		if err == nil || attempt >= attempts || ctx.Err() != nil || isControlSignal(err) || !shouldRetry(policy, err) {
+			return sourceHandle, err
+		}
+		lastErr = err
+	}
+}
+
+// runAttempt runs processFunc once, bounded by the node's timeout. A node that
+// fails because its own deadline passed is reported with a distinct message.
+func (wp *WorkflowProcessor) runAttempt(ctx context.Context, node *proto.Node, processFunc nodeProcessor) (string, error) {
+	timeout := time.Duration(node.Data.GetTimeoutMs()) * time.Millisecond
+	if timeout <= 0 {
+		return processFunc(ctx, node)
+	}
+	nodeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	sourceHandle, err := processFunc(nodeCtx, node)
+	if err != nil && ctx.Err() == nil && errors.Is(nodeCtx.Err(), context.DeadlineExceeded) {
+		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Node timed out after %s", timeout), true)
+		return sourceHandle, fmt.Errorf("node %s timed out after %s: %w", node.Id, timeout, err)
+	}
+	return sourceHandle, err
+}
+
+func nextRetryDelay(policy *proto.RetryPolicy, delay time.Duration) time.Duration {
+	multiplier := policy.GetMultiplier()
+	if multiplier <= 0 {
+		multiplier = defaultRetryMultiplier
+	}
+	next := time.Duration(float64(delay) * multiplier)
+	if maxDelay := time.Duration(policy.GetMaxDelayMs()) * time.Millisecond; maxDelay > 0 && next > maxDelay {
+		next = maxDelay
+	}
+	return next
+}
+
+// retryJitter returns a random wait between half of delay and delay, so runs
+// that failed together do not all retry at the same moment.
+func retryJitter(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + mrand.N(delay-half+1)
+}
+
+// shouldRetry reports whether err matches one of the policy's retryOn
+// entries. An empty list retries every error.
+func shouldRetry(policy *proto.RetryPolicy, err error) bool {
+	retryOn := policy.GetRetryOn()
+	if len(retryOn) == 0 {
+		return true
+	}
+	var statusErr *HTTPStatusError
+	isStatusErr := errors.As(err, &statusErr)
+	for _, condition := range retryOn {
+		switch condition {
+		case retryOnAny:
+			return true
+		case retryOnNetwork:
+			if !isStatusErr {
+				return true
+			}
+		case retryOn4xx, retryOn5xx:
+			if isStatusErr && strconv.Itoa(statusErr.StatusCode/100) == condition[:1] {
+				return true
+			}
+		default:
+			if isStatusErr && strconv.Itoa(statusErr.StatusCode) == condition {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isControlSignal reports whether err changes the flow of the run rather
+// than reporting a failure, so it is neither retried nor routed to error edges.
+func isControlSignal(err error) bool {
+	var parked *RunParkedError
+	return errors.As(err, &parked) || errors.Is(err, errBreak) || errors.Is(err, errContinue)
+}
+
+// delayWakeAt returns when a delay node finishes waiting: at a template
+// computed time in "until" mode, otherwise after interval units of type.
+func (wp *WorkflowProcessor) delayWakeAt(node *proto.Node) (time.Time, error) {
+	if node.Data.GetMode() == delayUntil {
+		value := strings.TrimSpace(wp.renderTemplate(node, node.Data.GetValue(), nil))
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+			if wakeAt, err := time.Parse(layout, value); err == nil {
+				return wakeAt, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("invalid delay time %q", value)
+	}
+	duration, err := intervalDuration(node)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(duration), nil
+}
+
+// intervalDuration reads a node's interval, counted in the unit named by its
+// type, as the Interval trigger does.
+func intervalDuration(node *proto.Node) (time.Duration, error) {
+	duration := time.Duration(node.Data.GetInterval())
+	switch node.Data.GetType() {
+	case "", "seconds":
+		duration *= time.Second
+	case "minutes":
+		duration *= time.Minute
+	case "hours":
+		duration *= time.Hour
+	case "days":
+		duration *= time.Hour * 24
+	default:
+		return 0, fmt.Errorf("invalid interval unit %q", node.Data.GetType())
+	}
+	return duration, nil
+}
+
+func matchCase(cases []string, value string) string {
+	for _, c := range cases {
+		if c == value {
+			return c
+		}
+	}
+	return ""
+}
+
+func approversText(approvers []string) string {
+	if len(approvers) == 0 {
+		return "any user"
+	}
+	return strings.Join(approvers, ", ")
+}
+
 func RegexReplaceAll(text, pattern, replaceText string) string {
 	re := regexp.MustCompile(pattern)
 	return re.ReplaceAllString(text, replaceText)
 }
 
-func CopyNode(n *proto.Node) proto.Node {
+func CopyNode(n *proto.Node) *proto.Node {
 	data, err := json.Marshal(n)
 	if err != nil {
-		return proto.Node{}
+		return &proto.Node{}
 	}
 	var newNode proto.Node
 	err = json.Unmarshal(data, &newNode)
 	if err != nil {
-		return proto.Node{}
+		return &proto.Node{}
 	}
-	return newNode
+	return &newNode
 }
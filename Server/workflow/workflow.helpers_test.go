@@ -0,0 +1,116 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+)
+
+func TestJoinSeesVariablesOfEveryBranch(t *testing.T) {
+	for _, parallel := range []bool{false, true} {
+		for run := 0; run < 50; run++ {
+			wp := &WorkflowProcessor{ID: t.Name(), Workflow: &proto.Workflow{Id: t.Name(), Nodes: []*proto.Node{
+				{Id: "a", Nodetype: "defaultnode", Data: &proto.NodeData{Parallel: ptr(parallel)}},
+				{Id: "b", Nodetype: "text", Data: &proto.NodeData{Message: ptr("from b"), Variable: ptr("vb")}},
+				{Id: "c", Nodetype: "text", Data: &proto.NodeData{Message: ptr("from c"), Variable: ptr("vc")}},
+				{Id: "j", Nodetype: "join"},
+				{Id: "d", Nodetype: "text", Data: &proto.NodeData{Message: ptr("{{.vb}}, {{.vc}}"), Variable: ptr("vd")}},
+			}, Edges: []*proto.Edge{
+				{Source: "a", Target: "b"},
+				{Source: "a", Target: "c"},
+				{Source: "b", Target: "j"},
+				{Source: "c", Target: "j"},
+				{Source: "j", Target: "d"},
+			}}}
+			if err := wp.ContinueWorkflow(context.Background(), "a", variablesOf(nil)); err != nil {
+				t.Fatal(err)
+			}
+			if vd, _ := wp.getVariable("vd"); vd.String() != "from b, from c" {
+				t.Fatalf("parallel=%v: node after the join rendered %q", parallel, vd.String())
+			}
+		}
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	network := errors.New("connection refused")
+	status := func(code int) error {
+		return fmt.Errorf("api call: %w", &HTTPStatusError{StatusCode: code, Status: fmt.Sprint(code)})
+	}
+	tests := []struct {
+		retryOn []string
+		err     error
+		want    bool
+	}{
+		{nil, network, true},
+		{nil, status(404), true},
+		{[]string{"any"}, status(400), true},
+		{[]string{"network"}, network, true},
+		{[]string{"network"}, status(503), false},
+		{[]string{"5xx"}, status(502), true},
+		{[]string{"5xx"}, status(500), true},
+		{[]string{"5xx"}, status(404), false},
+		{[]string{"5xx"}, network, false},
+		{[]string{"4xx"}, status(429), true},
+		{[]string{"4xx"}, status(502), false},
+		{[]string{"429"}, status(429), true},
+		{[]string{"429"}, status(428), false},
+		{[]string{"429", "503"}, status(503), true},
+		{[]string{"network", "5xx"}, status(504), true},
+		{[]string{"network", "5xx"}, status(401), false},
+		{[]string{"4xx"}, network, false},
+	}
+	for _, test := range tests {
+		policy := &proto.RetryPolicy{RetryOn: test.retryOn}
+		if got := shouldRetry(policy, test.err); got != test.want {
+			t.Errorf("retryOn %v, error %v: shouldRetry = %v, want %v", test.retryOn, test.err, got, test.want)
+		}
+	}
+}
+
+func TestNextRetryDelay(t *testing.T) {
+	tests := []struct {
+		policy *proto.RetryPolicy
+		delay  time.Duration
+		want   time.Duration
+	}{
+		{&proto.RetryPolicy{}, 100 * time.Millisecond, 200 * time.Millisecond},
+		{&proto.RetryPolicy{Multiplier: -1.0}, 100 * time.Millisecond, 200 * time.Millisecond},
+		{&proto.RetryPolicy{Multiplier: 3.0}, 100 * time.Millisecond, 300 * time.Millisecond},
+		{&proto.RetryPolicy{Multiplier: 1.5}, time.Second, 1500 * time.Millisecond},
+		{&proto.RetryPolicy{Multiplier: 1.0}, time.Second, time.Second},
+		{&proto.RetryPolicy{MaxDelayMs: 250}, 200 * time.Millisecond, 250 * time.Millisecond},
+		{&proto.RetryPolicy{MaxDelayMs: 250}, 100 * time.Millisecond, 200 * time.Millisecond},
+		{&proto.RetryPolicy{Multiplier: 10.0, MaxDelayMs: 1000}, time.Second, time.Second},
+		{&proto.RetryPolicy{}, 0, 0},
+		{nil, 50 * time.Millisecond, 100 * time.Millisecond},
+	}
+	for _, test := range tests {
+		if got := nextRetryDelay(test.policy, test.delay); got != test.want {
+			t.Errorf("policy %v after %s: nextRetryDelay = %s, want %s", test.policy, test.delay, got, test.want)
+		}
+	}
+}
+
+func TestRetryJitterStaysWithinBounds(t *testing.T) {
+	if got := retryJitter(0); got != 0 {
+		t.Errorf("retryJitter(0) = %s, want 0", got)
+	}
+	for _, delay := range []time.Duration{1, 3, time.Millisecond, 1500 * time.Millisecond, time.Hour} {
+		seen := make(map[time.Duration]bool)
+		for i := 0; i < 1000; i++ {
+			got := retryJitter(delay)
+			if got < delay/2 || got > delay {
+				t.Fatalf("retryJitter(%s) = %s, want between %s and %s", delay, got, delay/2, delay)
+			}
+			seen[got] = true
+		}
+		if delay >= time.Millisecond && len(seen) < 2 {
+			t.Errorf("retryJitter(%s) always waited %v", delay, seen)
+		}
+	}
+}
@@ -1,13 +1,19 @@
 package workflow
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
-	m "github.com/raenardcruz/floowsynk/Server/matheval"
+	DB "github.com/raenardcruz/floowsynk/Database"
+	"github.com/raenardcruz/floowsynk/Server/value"
 )
 
 const (
@@ -16,11 +22,11 @@ const (
 	Debug = "debug"
 )
 
-func (wp *WorkflowProcessor) DefaultNodeProcess(node *proto.Node) (string, error) {
+func (wp *WorkflowProcessor) DefaultNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) SetVariableNodeProcess(node *proto.Node) (string, error) {
+func (wp *WorkflowProcessor) SetVariableNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	name := node.Data.Name
 	value := node.Data.Value
 	defer func() {
@@ -28,124 +34,269 @@ func (wp *WorkflowProcessor) SetVariableNodeProcess(node *proto.Node) (string, e
 		replayNode.Data.Name = name
 		replayNode.Data.Value = value
 		wp.setVariable(*name, *value)
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, *value, fmt.Sprintf("Variable %s set to %v", *name, *value), true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, *value, fmt.Sprintf("Variable %s set to %v", *name, *value), true)
 	}()
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) ConditionNodeProcess(node *proto.Node) (retVal string, err error) {
-	expression := wp.populateTemplate(*node.Data.Expression, nil)
+func (wp *WorkflowProcessor) ConditionNodeProcess(ctx context.Context, node *proto.Node) (retVal string, err error) {
+	prepared := wp.prepareExpression(node)
+	expression := prepared.display
 	defer func() {
 		replayNode := CopyNode(node)
 		replayNode.Data.Expression = &expression
 		if err != nil {
-			wp.UpdateStatus(&replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Condition %s failed: %v", expression, err), true)
+			wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Condition %s failed: %v", expression, err), true)
 		} else {
-			wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, nil, fmt.Sprintf("Condition %s processed: %s", expression, retVal), true)
+			wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, nil, fmt.Sprintf("Condition %s processed: %s", expression, retVal), true)
 		}
 	}()
 	if expression == "" {
 		return FALSE, errors.New("condition not found")
 	}
-	if res, _ := m.EvaluateBoolean(expression); res {
+	res, err := wp.evaluateBoolean(node, prepared)
+	if err != nil {
+		return "", err
+	}
+	if res {
 		return TRUE, nil
-	} else {
-		return FALSE, nil
 	}
+	return FALSE, nil
 }
 
-func (wp *WorkflowProcessor) TextNodeProcess(node *proto.Node) (string, error) {
-	text := wp.populateTemplate(*node.Data.Message, nil)
+// SwitchNodeProcess evaluates its expression once and follows the handle of
+// the case equal to the result, or the default handle when none is. The
+// rendered text is compared first, so {{.status}} matches a case as is; the
+// prepared expression is evaluated only when the text matches no case.
+func (wp *WorkflowProcessor) SwitchNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	prepared := wp.prepareExpression(node)
+	result := strings.TrimSpace(prepared.display)
+	replayNode := CopyNode(node)
+	replayNode.Data.Expression = &result
+	cases := node.Data.GetCases().GetStringItems()
+	handle := matchCase(cases, result)
+	if handle == "" {
+		if res, err := wp.evaluateValue(node, prepared); err == nil {
+			result = value.From(res).String()
+			handle = matchCase(cases, result)
+		}
+	}
+	if handle == "" {
+		handle = DEFAULT
+	}
+	wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, result, fmt.Sprintf("Switch %s routed to %s", result, handle), true)
+	return handle, nil
+}
+
+func (wp *WorkflowProcessor) TextNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	text := wp.renderTemplate(node, *node.Data.Message, nil)
 	varName := node.Data.Variable
 	defer func() {
 		replayNode := CopyNode(node)
 		replayNode.Data.Message = &text
 		replayNode.Data.Variable = varName
 		wp.setVariable(*varName, text)
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, text, fmt.Sprintf("Text %s set to %s", *varName, text), true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, text, fmt.Sprintf("Text %s set to %s", *varName, text), true)
 	}()
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) LoopNodeProcess(node *proto.Node) (string, error) {
+func (wp *WorkflowProcessor) LoopNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	iteration := *node.Data.Iteration
+	results := make([]value.Value, 0, iteration)
 	for i := 0; i < int(iteration); i++ {
 		replayNode := CopyNode(node)
 		tmpIteration := int32(i)
 		replayNode.Data.Iteration = &tmpIteration
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_INFO, nil, fmt.Sprintf("Running Iteration %d of %d", i, iteration), true)
-		if err := wp.nextProcess(node.Id, ""); err != nil {
-			wp.UpdateStatus(&replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing loop iteration %d: %v", i, err), true)
+		if err := ctx.Err(); err != nil {
+			wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Loop stopped at iteration %d: %v", i, err), true)
 			return "", err
 		}
+		wp.UpdateStatus(replayNode, proto.NodeStatus_INFO, nil, fmt.Sprintf("Running Iteration %d of %d", i, iteration), true)
+		if err := wp.nextProcess(ctx, node.Id, BODY); err != nil {
+			if errors.Is(err, errBreak) {
+				wp.UpdateStatus(replayNode, proto.NodeStatus_INFO, nil, fmt.Sprintf("Loop stopped by break at iteration %d", i), true)
+				break
+			}
+			if !errors.Is(err, errContinue) {
+				if !isControlSignal(err) {
+					wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing loop iteration %d: %v", i, err), true)
+				}
+				return "", err
+			}
+		}
+		output, _ := wp.getVariable(OUTPUT)
+		results = append(results, output)
 	}
+	wp.setLoopResults(node, results)
 	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, nil, "Loop processed successfully", true)
-	return "", nil
+	return DONE, nil
 }
 
-func (wp *WorkflowProcessor) ForEachNodeProcess(node *proto.Node) (string, error) {
+func (wp *WorkflowProcessor) ForEachNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	listvar := node.Data.Listvar
-	listitems := wp.ProcessVariables[*listvar]
+	listitems, _ := wp.getVariable(*listvar)
 
-	items, ok := listitems.([]interface{})
+	items, ok := listitems.AsList()
 	if !ok {
 		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, "List variable is not a valid List", true)
 		return "", errors.New("list variable is not a valid List")
 	}
+	if node.Data.GetParallelItems() {
+		return wp.parallelForEach(ctx, node, items)
+	}
 
-	for _, item := range items {
-		switch v := item.(type) {
-		case string, float64, bool, KeyValue:
-			replayNode := CopyNode(node)
-			replayNode.Data.Listvar = listvar
-			wp.UpdateStatus(&replayNode, proto.NodeStatus_INFO, v, fmt.Sprintf("Processing item %v in Foreach Loop", v), true)
-			wp.setVariable(OUTPUT, v)
-			if err := wp.nextProcess(node.Id, ""); err != nil {
-				wp.UpdateStatus(&replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing item %v: %v", v, err), true)
+	results := make([]value.Value, 0, len(items))
+	for _, v := range items {
+		replayNode := CopyNode(node)
+		replayNode.Data.Listvar = listvar
+		if err := ctx.Err(); err != nil {
+			wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Foreach Loop stopped at item %v: %v", v, err), true)
+			return "", err
+		}
+		wp.UpdateStatus(replayNode, proto.NodeStatus_INFO, v, fmt.Sprintf("Processing item %v in Foreach Loop", v), true)
+		wp.setVariable(OUTPUT, v)
+		if err := wp.nextProcess(ctx, node.Id, BODY); err != nil {
+			if errors.Is(err, errBreak) {
+				wp.UpdateStatus(replayNode, proto.NodeStatus_INFO, nil, fmt.Sprintf("Foreach Loop stopped by break at item %v", v), true)
+				break
+			}
+			if !errors.Is(err, errContinue) {
+				if !isControlSignal(err) {
+					wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing item %v: %v", v, err), true)
+				}
 				return "", err
 			}
-		default:
-			wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, "Invalid item type in List", true)
-			return "", errors.New("invalid item type in List")
 		}
+		output, _ := wp.getVariable(OUTPUT)
+		results = append(results, output)
 	}
 
+	wp.setLoopResults(node, results)
 	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, nil, fmt.Sprintf("Foreach Loop completed for variable %s", *listvar), true)
-	return "", nil
+	return DONE, nil
+}
+
+// parallelForEach runs the body for each item on its own goroutine, at most
+// concurrencyLimit at a time, each in its own copy of the variables. Only the
+// item outputs come back, in the order of the items. Item bodies are not
+// checkpointed, so a resumed run repeats the whole foreach.
+func (wp *WorkflowProcessor) parallelForEach(ctx context.Context, node *proto.Node, items []value.Value) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	base := wp.snapshotVariables()
+	sem := make(chan struct{}, concurrencyLimit(node))
+	errs := make(chan error, len(items))
+	outputs := make([]value.Value, len(items))
+	ran := make([]bool, len(items))
+	var stopped atomic.Bool
+	var wg sync.WaitGroup
+	for i, item := range items {
+		sem <- struct{}{}
+		if stopped.Load() || ctx.Err() != nil {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(i int, item value.Value) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			variables := make(map[string]value.Value, len(base))
+			for k, v := range base {
+				variables[k] = v
+			}
+			variables[OUTPUT] = item
+			scope := wp.newScope(variables)
+			scope.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("Processing item %v in Foreach Loop", item), true)
+			if err := scope.nextProcess(ctx, node.Id, BODY); err != nil {
+				if errors.Is(err, errBreak) {
+					stopped.Store(true)
+					scope.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("Foreach Loop stopped by break at item %v", item), true)
+					return
+				}
+				if !errors.Is(err, errContinue) {
+					errs <- err
+					cancel()
+					return
+				}
+			}
+			outputs[i], _ = scope.getVariable(OUTPUT)
+			ran[i] = true
+		}(i, item)
+	}
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		if !isControlSignal(err) {
+			wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing Foreach Loop: %v", err), true)
+		}
+		return "", err
+	}
+	if err := ctx.Err(); err != nil {
+		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Foreach Loop stopped: %v", err), true)
+		return "", err
+	}
+	results := make([]value.Value, 0, len(items))
+	for i, output := range outputs {
+		if ran[i] {
+			results = append(results, output)
+		}
+	}
+	wp.setLoopResults(node, results)
+	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, nil, fmt.Sprintf("Foreach Loop completed %d items in parallel", len(results)), true)
+	return DONE, nil
 }
 
-func (wp *WorkflowProcessor) WhileNodeProcess(node *proto.Node) (string, error) {
-	expression := wp.populateTemplate(*node.Data.Expression, nil)
+func (wp *WorkflowProcessor) WhileNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	prepared := wp.prepareExpression(node)
+	expression := prepared.display
 	replayNode := CopyNode(node)
 	replayNode.Data.Expression = &expression
 	limit := int(*node.Data.Limit)
 	cur := 0
-	res, err := m.EvaluateBoolean(expression)
+	results := make([]value.Value, 0)
+	res, err := wp.evaluateBoolean(node, prepared)
 	if err != nil {
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error evaluating while condition '%s': %v", expression, err), true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error evaluating while condition '%s': %v", expression, err), true)
 		return "", err
 	}
 	for res && cur < limit {
 		iteration := int32(cur)
 		replayNode.Data.Iteration = &iteration
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_INFO, nil, fmt.Sprintf("While Loop iteration %d with condition '%s'", cur, expression), true)
-		if err := wp.nextProcess(node.Id, ""); err != nil {
-			wp.UpdateStatus(&replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error during While Loop iteration %d: %v", cur, err), true)
+		if err := ctx.Err(); err != nil {
+			wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("While Loop stopped at iteration %d: %v", cur, err), true)
 			return "", err
 		}
-		expression = wp.populateTemplate(*node.Data.Expression, nil)
-		res, err = m.EvaluateBoolean(expression)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_INFO, nil, fmt.Sprintf("While Loop iteration %d with condition '%s'", cur, expression), true)
+		if err := wp.nextProcess(ctx, node.Id, BODY); err != nil {
+			if errors.Is(err, errBreak) {
+				wp.UpdateStatus(replayNode, proto.NodeStatus_INFO, nil, fmt.Sprintf("While Loop stopped by break at iteration %d", cur), true)
+				break
+			}
+			if !errors.Is(err, errContinue) {
+				if !isControlSignal(err) {
+					wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error during While Loop iteration %d: %v", cur, err), true)
+				}
+				return "", err
+			}
+		}
+		output, _ := wp.getVariable(OUTPUT)
+		results = append(results, output)
+		prepared = wp.prepareExpression(node)
+		expression = prepared.display
+		res, err = wp.evaluateBoolean(node, prepared)
 		if err != nil {
-			wp.UpdateStatus(&replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error re-evaluating while condition '%s': %v", expression, err), true)
+			wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error re-evaluating while condition '%s': %v", expression, err), true)
 			return "", err
 		}
 		cur++
 	}
-	wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, nil, "While Loop completed successfully", true)
-	return "", nil
+	wp.setLoopResults(node, results)
+	wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, nil, "While Loop completed successfully", true)
+	return DONE, nil
 }
 
-func (wp *WorkflowProcessor) ListNodeProcess(node *proto.Node) (string, error) {
+func (wp *WorkflowProcessor) ListNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	nodeDataArray := node.Data.List
 	varName := *node.Data.Variable
 	listItems := make([]interface{}, 0)
@@ -176,42 +327,52 @@ func (wp *WorkflowProcessor) ListNodeProcess(node *proto.Node) (string, error) {
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) ApiNodeProcess(node *proto.Node) (string, error) {
-	url := wp.populateTemplate(*node.Data.Url, nil)
+func (wp *WorkflowProcessor) ApiNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	url := wp.renderTemplate(node, *node.Data.Url, nil)
 	method := *node.Data.Method
 	nodeDataArray := node.Data.Headers
 	headers := make([]*proto.KeyValue, 0)
 	headers = append(headers, nodeDataArray.KeyValueItems...)
-	payloadStr := wp.populateTemplate(*node.Data.Payload, nil)
+	payloadStr := wp.renderTemplate(node, *node.Data.Payload, nil)
 	variable := *node.Data.Variable
 	var payload interface{}
 	json.Unmarshal([]byte(payloadStr), &payload)
-	response, err := wp.makeRequest(url, method, headers, payload)
+	response, err := wp.makeRequest(ctx, url, method, headers, payload, node.Data.GetRetry())
 	replayNode := CopyNode(node)
 	replayNode.Data.Url = &url
 	replayNode.Data.Payload = &payloadStr
 	if err != nil {
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing API %s: %v", url, err), true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing API %s: %v", url, err), true)
 		return "", err
 	}
 	defer func() {
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, response, fmt.Sprintf("API %s processed successfully", url), true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, response, fmt.Sprintf("API %s processed successfully", url), true)
 	}()
 	wp.setVariable(variable, response)
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) LogNodeProcess(node *proto.Node) (string, error) {
-	message := wp.populateTemplate(*node.Data.Message, nil)
+func (wp *WorkflowProcessor) LogNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	message := wp.renderTemplate(node, *node.Data.Message, nil)
 	defer func() {
 		replayNode := CopyNode(node)
 		replayNode.Data.Message = &message
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, nil, message, true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, nil, message, true)
 	}()
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) GuidNodeProcess(node *proto.Node) (string, error) {
+// ImageNodeProcess only reports the rendered image source; the App displays
+// it from the replay.
+func (wp *WorkflowProcessor) ImageNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	source := wp.renderTemplate(node, node.Data.GetValue(), nil)
+	replayNode := CopyNode(node)
+	replayNode.Data.Value = &source
+	wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, source, "Image displayed", true)
+	return "", nil
+}
+
+func (wp *WorkflowProcessor) GuidNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	varName := node.Data.Variable
 	newGuid := generateGUID()
 	defer func() {
@@ -221,27 +382,29 @@ func (wp *WorkflowProcessor) GuidNodeProcess(node *proto.Node) (string, error) {
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) MathNodeProcess(node *proto.Node) (string, error) {
-	expression := wp.populateTemplate(*node.Data.Expression, nil)
+func (wp *WorkflowProcessor) MathNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	prepared := wp.prepareExpression(node)
+	expression := prepared.display
 	varName := node.Data.Variable
-	res, err := m.EvaluateNumeric(expression)
+	res, err := wp.evaluateNumeric(node, prepared)
 	replayNode := CopyNode(node)
 	replayNode.Data.Expression = &expression
 	if err != nil {
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing Math %s: %v", expression, err), true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error processing Math %s: %v", expression, err), true)
 		return "", err
 	}
 	wp.setVariable(*varName, res)
-	wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, res, fmt.Sprintf("Math %s processed successfully with a value of %f", expression, res), true)
+	wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, res, fmt.Sprintf("Math %s processed successfully with a value of %f", expression, res), true)
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) CountNodeProcess(node *proto.Node) (string, error) {
+func (wp *WorkflowProcessor) CountNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	varName := node.Data.Variable
 	listVar := node.Data.ListVariable
 	count := 0
-	if listVarValue, ok := wp.ProcessVariables[*listVar].([]interface{}); ok {
-		count = count + len(listVarValue)
+	listVarValue, _ := wp.getVariable(*listVar)
+	if items, ok := listVarValue.AsList(); ok {
+		count = count + len(items)
 	} else {
 		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, "List variable not found or is not a valid List", true)
 		return "", errors.New("list variable not found or is not a valid List")
@@ -251,65 +414,57 @@ func (wp *WorkflowProcessor) CountNodeProcess(node *proto.Node) (string, error)
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) MapNodeProcess(node *proto.Node) (string, error) {
-	mappedList := make([]any, 0)
+func (wp *WorkflowProcessor) MapNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	listVar := node.Data.ListVariable
 	variable := node.Data.Variable
 	templateStr := node.Data.Template
-	listVarValue, ok := wp.ProcessVariables[*listVar]
+	listVarValue, ok := wp.getVariable(*listVar)
 
 	if !ok {
 		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, "List variable not found or is not a valid List", true)
 		return "", errors.New("list variable not found or is not a valid List")
 	}
 
-	switch items := listVarValue.(type) {
-	case []any:
-		for _, item := range items {
-			var itemMap map[string]any
-			switch v := item.(type) {
-			case string:
-				if err := json.Unmarshal([]byte(v), &itemMap); err != nil {
-					itemMap = map[string]any{"value": v}
-				}
-			case map[string]any:
-				itemMap = v
-			case float64, bool:
-				itemMap = map[string]any{"value": v}
-			case KeyValue:
-				itemMap = map[string]any{"key": v.Key, "value": v.Value}
-			default:
-				wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, "invalid item type", true)
-				return "", fmt.Errorf("invalid item type")
-			}
-			mappedItemStr := wp.populateTemplate(*templateStr, itemMap)
-			var mappedItem any
-			if err := json.Unmarshal([]byte(mappedItemStr), &mappedItem); err != nil {
-				mappedItem = mappedItemStr
-			}
-			mappedList = append(mappedList, mappedItem)
-		}
-	default:
+	items, ok := listVarValue.AsList()
+	if !ok {
 		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, "List variable is not a valid List", true)
 		return "", errors.New("list variable is not a valid List")
 	}
+	mappedList := make([]value.Value, 0, len(items))
+	for _, item := range items {
+		itemMap, ok := item.AsMap()
+		if text, isString := item.AsString(); isString {
+			if parsed, err := value.FromJSON([]byte(text)); err == nil {
+				itemMap, ok = parsed.AsMap()
+			}
+		}
+		if !ok {
+			itemMap = map[string]value.Value{"value": item}
+		}
+		mappedItemStr := wp.renderTemplate(node, *templateStr, itemMap)
+		mappedItem, err := value.FromJSON([]byte(mappedItemStr))
+		if err != nil {
+			mappedItem = value.String(mappedItemStr)
+		}
+		mappedList = append(mappedList, mappedItem)
+	}
 
 	wp.setVariable(*variable, mappedList)
 	replayNode := CopyNode(node)
 	replayNode.Data.ListVariable = listVar
 	replayNode.Data.Variable = variable
 	replayNode.Data.Template = templateStr
-	mappedListJSON, _ := json.MarshalIndent(mappedList, "", "  ")
+	mappedListJSON, _ := json.MarshalIndent(value.List(mappedList).Native(), "", "  ")
 	mappedListStr := string(mappedListJSON)
 	replayNode.Data.Text = &mappedListStr
-	wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, mappedList, fmt.Sprintf("Map %s processed successfully with a value of %v", *variable, mappedList), true)
+	wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, mappedList, fmt.Sprintf("Map %s processed successfully with a value of %v", *variable, mappedList), true)
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) ReplaceNodeProcess(node *proto.Node) (string, error) {
-	text := wp.populateTemplate(*node.Data.Text, nil)
-	pattern := wp.populateTemplate(*node.Data.Pattern, nil)
-	replaceText := wp.populateTemplate(*node.Data.ReplaceText, nil)
+func (wp *WorkflowProcessor) ReplaceNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	text := wp.renderTemplate(node, *node.Data.Text, nil)
+	pattern := wp.renderTemplate(node, *node.Data.Pattern, nil)
+	replaceText := wp.renderTemplate(node, *node.Data.ReplaceText, nil)
 	varName := node.Data.Variable
 	newText := RegexReplaceAll(text, pattern, replaceText)
 	defer func() {
@@ -317,15 +472,15 @@ func (wp *WorkflowProcessor) ReplaceNodeProcess(node *proto.Node) (string, error
 		replayNode.Data.Text = &text
 		replayNode.Data.Pattern = &pattern
 		replayNode.Data.ReplaceText = &replaceText
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, newText, fmt.Sprintf("Replace %s processed successfully with a value of %s", *varName, newText), true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, newText, fmt.Sprintf("Replace %s processed successfully with a value of %s", *varName, newText), true)
 	}()
 	wp.setVariable(*varName, newText)
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) FindAllNodeProcess(node *proto.Node) (string, error) {
-	text := wp.populateTemplate(*node.Data.Text, nil)
-	pattern := wp.populateTemplate(*node.Data.Pattern, nil)
+func (wp *WorkflowProcessor) FindAllNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	text := wp.renderTemplate(node, *node.Data.Text, nil)
+	pattern := wp.renderTemplate(node, *node.Data.Pattern, nil)
 	varName := node.Data.Variable
 	re := regexp.MustCompile(pattern)
 	matches := re.FindAllString(text, -1)
@@ -333,32 +488,185 @@ func (wp *WorkflowProcessor) FindAllNodeProcess(node *proto.Node) (string, error
 		replayNode := CopyNode(node)
 		replayNode.Data.Text = &text
 		replayNode.Data.Pattern = &pattern
-		wp.UpdateStatus(&replayNode, proto.NodeStatus_COMPLETED, matches, fmt.Sprintf("FindAll %s processed successfully with a value of %v", *varName, matches), true)
+		wp.UpdateStatus(replayNode, proto.NodeStatus_COMPLETED, matches, fmt.Sprintf("FindAll %s processed successfully with a value of %v", *varName, matches), true)
 	}()
 	wp.setVariable(*varName, matches)
 	return "", nil
 }
 
-func (wp *WorkflowProcessor) SubProcessNodeProcess(node *proto.Node) (string, error) {
+// SubProcessNodeProcess runs another workflow like a function call. The child
+// starts with only the node's inputs, each a template evaluated in the caller,
+// and its input set to the caller's output. Once it completes, the outputs
+// copy child variables back into the caller and the node's output is the
+// child's output.
+func (wp *WorkflowProcessor) SubProcessNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
 	subProcessId := node.Data.SubProcessId
-	defer func() {
-		wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, nil, fmt.Sprintf("SubProcess %s completed successfully", *subProcessId), true)
-	}()
+	if wp.depth >= maxSubprocessDepth {
+		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("SubProcess %s not started: subprocess calls are nested more than %d deep, check for a workflow that calls itself", *subProcessId, maxSubprocessDepth), true)
+		return "", fmt.Errorf("subprocess %s exceeds the maximum call depth of %d", *subProcessId, maxSubprocessDepth)
+	}
 	workflow, err := wp.DBcon.GetWorkflow(*subProcessId)
 	if err != nil {
 		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error retrieving SubProcess %s: %v", *subProcessId, err), true)
 		return "", err
 	}
-	subProcessor := &WorkflowProcessor{
-		Stream:           wp.Stream,
-		Workflow:         workflow,
-		ProcessVariables: wp.ProcessVariables,
-		DBcon:            wp.DBcon,
+	output, _ := wp.getVariable(OUTPUT)
+	variables := map[string]value.Value{INPUT: output, OUTPUT: output}
+	for _, input := range node.Data.GetInputs().GetKeyValueItems() {
+		variables[input.Key] = wp.evaluateBinding(input.Value)
+	}
+	subProcessor := wp.newSubprocess(node, workflow, variables)
+	wp.setVariable(SUBPROCESS_RUNID, subProcessor.ID)
+	wp.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("SubProcess %s is running as run %s", *subProcessId, subProcessor.ID), true)
+	if err := subProcessor.Process(ctx, subProcessor.startNodeId()); err != nil {
+		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("SubProcess %s failed: %v", *subProcessId, err), true)
+		if errors.Is(err, errBreak) || errors.Is(err, errContinue) {
+			// Loop control does not reach loops outside the subprocess.
+			return "", fmt.Errorf("subprocess %s: %v", *subProcessId, err)
+		}
+		return "", err
+	}
+	for _, binding := range node.Data.GetOutputs().GetKeyValueItems() {
+		result, _ := subProcessor.getVariable(binding.Value)
+		wp.setVariable(binding.Key, result)
+	}
+	result, _ := subProcessor.getVariable(OUTPUT)
+	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, result, fmt.Sprintf("SubProcess %s completed successfully", *subProcessId), true)
+	return "", nil
+}
+
+func (wp *WorkflowProcessor) JoinNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	arrivals := wp.takeJoinArrivals(node.Id)
+	// Parallel branches ran in their own scopes; what they set is carried on
+	// past the join, in the order they arrived.
+	for _, arrival := range arrivals {
+		for k, v := range arrival.Variables {
+			wp.setVariable(k, v)
+		}
+	}
+	var merged value.Value
+	if node.Data.GetMergeAs() == mergeMap {
+		outputs := make(map[string]value.Value, len(arrivals))
+		for _, arrival := range arrivals {
+			outputs[arrival.Source] = arrival.Output
+		}
+		merged = value.Map(outputs)
+	} else {
+		outputs := make([]value.Value, 0, len(arrivals))
+		for _, arrival := range arrivals {
+			outputs = append(outputs, arrival.Output)
+		}
+		merged = value.List(outputs)
+	}
+	if variable := node.Data.GetVariable(); variable != "" {
+		wp.setVariable(variable, merged)
+	}
+	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, merged, fmt.Sprintf("Join merged %d branches", len(arrivals)), true)
+	return "", nil
+}
+
+func (wp *WorkflowProcessor) DelayNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	wakeAt, err := wp.delayWakeAt(node)
+	if err != nil {
+		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error computing delay: %v", err), true)
+		return "", err
+	}
+	wait := time.Until(wakeAt)
+	if wait >= minParkDelay {
+		// Only durable runs can park; the others would hold a goroutine for
+		// the whole wait.
+		if !wp.Durable {
+			err := fmt.Errorf("delay of %s requires a saved workflow run; only delays under %s can run here", wait.Round(time.Second), minParkDelay)
+			wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error waiting: %v", err), true)
+			return "", err
+		}
+		wp.UpdateStatus(node, proto.NodeStatus_WAITING, nil, fmt.Sprintf("Waiting until %s", wakeAt.Format(time.RFC3339)), true)
+		return "", &RunParkedError{NodeID: node.Id, WakeAt: wakeAt, Done: true}
 	}
-	wp.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("SubProcess %s is running", *subProcessId), true)
-	subProcessor.Process("0")
-	for key, value := range subProcessor.ProcessVariables {
-		wp.ProcessVariables[key] = value
+	if wait > 0 {
+		wp.UpdateStatus(node, proto.NodeStatus_INFO, nil, fmt.Sprintf("Waiting %s", wait.Round(time.Millisecond)), true)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Delay stopped: %v", ctx.Err()), true)
+			return "", ctx.Err()
+		}
 	}
+	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, nil, "Delay completed", true)
 	return "", nil
 }
+
+// ApprovalNodeProcess parks the run until an approver decides, then follows
+// the approved, rejected or timeout handle. The node runs again when the run
+// wakes and picks up the recorded decision.
+func (wp *WorkflowProcessor) ApprovalNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	if !wp.Durable {
+		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, "Approval requires a saved workflow run", true)
+		return "", errors.New("approval requires a durable run")
+	}
+	approval, found, err := wp.DBcon.GetOpenApproval(wp.ID, node.Id)
+	if err != nil {
+		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error loading approval: %v", err), true)
+		return "", err
+	}
+	if !found {
+		approval = DB.Approval{
+			ProcessID: wp.ID,
+			NodeID:    node.Id,
+			Approvers: node.Data.GetApprovers().GetStringItems(),
+		}
+		if node.Data.GetInterval() > 0 {
+			expiry, err := intervalDuration(node)
+			if err != nil {
+				wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error computing approval expiry: %v", err), true)
+				return "", err
+			}
+			approval.ExpiresAt = time.Now().Add(expiry).UTC().Unix()
+		}
+		if err := wp.DBcon.CreateApproval(&approval); err != nil {
+			wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error creating approval: %v", err), true)
+			return "", err
+		}
+	}
+	if approval.Decision == DB.ApprovalPending && approval.ExpiresAt > 0 && time.Now().UTC().Unix() >= approval.ExpiresAt {
+		if _, err := wp.DBcon.DecideApproval(approval.ID, DB.ApprovalTimeout, "", "Approval expired"); err != nil {
+			wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error expiring approval: %v", err), true)
+			return "", err
+		}
+		if approval, _, err = wp.DBcon.GetOpenApproval(wp.ID, node.Id); err != nil {
+			wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error loading approval: %v", err), true)
+			return "", err
+		}
+	}
+	if approval.Decision == DB.ApprovalPending {
+		var wakeAt time.Time
+		if approval.ExpiresAt > 0 {
+			wakeAt = time.Unix(approval.ExpiresAt, 0)
+		}
+		wp.UpdateStatus(node, proto.NodeStatus_WAITING, nil, fmt.Sprintf("Waiting for approval from %s", approversText(approval.Approvers)), true)
+		return "", &RunParkedError{NodeID: node.Id, WakeAt: wakeAt}
+	}
+	if err := wp.DBcon.ConsumeApproval(approval.ID); err != nil {
+		wp.UpdateStatus(node, proto.NodeStatus_FAILED, nil, fmt.Sprintf("Error updating approval: %v", err), true)
+		return "", err
+	}
+	wp.setVariable(APPROVAL_DECISION, approval.Decision)
+	wp.setVariable(APPROVAL_DECIDEDBY, approval.DecidedBy)
+	wp.setVariable(APPROVAL_COMMENT, approval.Comment)
+	message := fmt.Sprintf("Step %s by %s", approval.Decision, approval.DecidedBy)
+	if approval.Decision == DB.ApprovalTimeout {
+		message = "Approval timed out"
+	}
+	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, approval.Decision, message, true)
+	return approval.Decision, nil
+}
+
+func (wp *WorkflowProcessor) BreakNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, nil, "Break: leaving the enclosing loop", true)
+	return "", errBreak
+}
+
+func (wp *WorkflowProcessor) ContinueNodeProcess(ctx context.Context, node *proto.Node) (string, error) {
+	wp.UpdateStatus(node, proto.NodeStatus_COMPLETED, nil, "Continue: skipping to the next iteration", true)
+	return "", errContinue
+}
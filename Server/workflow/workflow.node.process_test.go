@@ -0,0 +1,66 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+)
+
+func TestSwitchRoutesOnDataWithoutParsingIt(t *testing.T) {
+	tests := []struct {
+		expression string
+		variables  map[string]interface{}
+		want       string
+	}{
+		{"{{.status}}", map[string]interface{}{"status": "shipped"}, "shipped"},
+		{"{{.status}}", map[string]interface{}{"status": "2"}, "2"},
+		{"{{.status}}", map[string]interface{}{"status": "2.0"}, "2"},
+		{"{{.status}}", map[string]interface{}{"status": "1 + 1"}, DEFAULT},
+		{"{{.status}}", map[string]interface{}{"status": `"shipped"`}, DEFAULT},
+		{"{{.status}}", map[string]interface{}{"status": "pending"}, DEFAULT},
+		{"{{.n}} + 1", map[string]interface{}{"n": 1.0}, "2"},
+		{`lower("{{.status}}")`, map[string]interface{}{"status": "SHIPPED"}, "shipped"},
+	}
+	for _, test := range tests {
+		wp := &WorkflowProcessor{ID: t.Name(), Workflow: &proto.Workflow{Id: t.Name(), Nodes: []*proto.Node{
+			{Id: "0", Nodetype: "defaultnode"},
+			{Id: "s", Nodetype: "switch", Data: &proto.NodeData{
+				Expression: ptr(test.expression),
+				Cases:      &proto.NodeDataArray{StringItems: []string{"shipped", "2"}},
+			}},
+			{Id: "a", Nodetype: "text", Data: &proto.NodeData{Message: ptr("shipped"), Variable: ptr("route")}},
+			{Id: "b", Nodetype: "text", Data: &proto.NodeData{Message: ptr("2"), Variable: ptr("route")}},
+			{Id: "d", Nodetype: "text", Data: &proto.NodeData{Message: ptr(DEFAULT), Variable: ptr("route")}},
+		}, Edges: []*proto.Edge{
+			{Source: "0", Target: "s"},
+			{Source: "s", Target: "a", Sourcehandle: "shipped"},
+			{Source: "s", Target: "b", Sourcehandle: "2"},
+			{Source: "s", Target: "d", Sourcehandle: DEFAULT},
+		}}}
+		if err := wp.ContinueWorkflow(context.Background(), "0", variablesOf(test.variables)); err != nil {
+			t.Fatal(err)
+		}
+		if route, _ := wp.getVariable("route"); route.String() != test.want {
+			t.Errorf("%s with %v routed to %q, want %q", test.expression, test.variables, route.String(), test.want)
+		}
+	}
+}
+
+func TestLongDelayFailsOutsideDurableRuns(t *testing.T) {
+	wp := &WorkflowProcessor{ID: t.Name(), Workflow: &proto.Workflow{Id: t.Name(), Nodes: []*proto.Node{
+		{Id: "0", Nodetype: "defaultnode"},
+		{Id: "d", Nodetype: "delay", Data: &proto.NodeData{Interval: ptr(int32(2)), Type: ptr("days")}},
+	}, Edges: []*proto.Edge{{Source: "0", Target: "d"}}}}
+	done := make(chan error, 1)
+	go func() { done <- wp.StartWorkflow(context.Background()) }()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("a two-day delay in a run that cannot park succeeded")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("a two-day delay in a run that cannot park is waiting in place")
+	}
+}
@@ -0,0 +1,61 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// CANCEL_RUN_CHANNEL is the Redis channel used to forward CancelRun requests
+// to the server replica that is executing the run.
+const CANCEL_RUN_CHANNEL = "workflow:cancel"
+
+var ErrRunCancelled = errors.New("run cancelled")
+
+// activeRun is a run executing on this server.
+type activeRun struct {
+	cancel     context.CancelCauseFunc
+	workflowID string
+	startedBy  string
+}
+
+// activeRuns holds every run executing on this server, keyed by
+// WorkflowProcessor.ID.
+var activeRuns = struct {
+	sync.Mutex
+	runs map[string]activeRun
+}{runs: make(map[string]activeRun)}
+
+func (wp *WorkflowProcessor) registerRun(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	id := wp.ID
+	activeRuns.Lock()
+	activeRuns.runs[id] = activeRun{cancel: cancel, workflowID: wp.Workflow.GetId(), startedBy: wp.StartedBy}
+	activeRuns.Unlock()
+	return ctx, func() {
+		activeRuns.Lock()
+		delete(activeRuns.runs, id)
+		activeRuns.Unlock()
+		cancel(nil)
+	}
+}
+
+// CancelRun cancels a run executing on this server and reports whether it was found.
+func CancelRun(id string) bool {
+	activeRuns.Lock()
+	run, ok := activeRuns.runs[id]
+	activeRuns.Unlock()
+	if ok {
+		run.cancel(ErrRunCancelled)
+	}
+	return ok
+}
+
+// ActiveRunOwner returns the workflow of a run executing on this server and
+// the user who started it, and reports whether the run was found.
+func ActiveRunOwner(id string) (workflowID, startedBy string, ok bool) {
+	activeRuns.Lock()
+	run, ok := activeRuns.runs[id]
+	activeRuns.Unlock()
+	return run.workflowID, run.startedBy, ok
+}
@@ -0,0 +1,117 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/IBM/sarama"
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	DB "github.com/raenardcruz/floowsynk/Database"
+	"github.com/raenardcruz/floowsynk/Server/value"
+)
+
+// schedulerInterval is how often parked runs are checked for a due WakeAt and
+// orphaned runs are recovered.
+const schedulerInterval = 15 * time.Second
+
+// StartScheduler wakes parked runs once their WakeAt has passed and recovers
+// runs left behind by a stopped server, until ctx is done. Every replica may
+// run it; the run lease and ClaimRun make sure a run is woken once.
+func StartScheduler(ctx context.Context, dbcon *DB.DatabaseConnection, producer *sarama.SyncProducer) {
+	go func() {
+		ticker := time.NewTicker(schedulerInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				wakeDueRuns(ctx, dbcon, producer)
+				RecoverRuns(ctx, dbcon, producer)
+			}
+		}
+	}()
+}
+
+func wakeDueRuns(ctx context.Context, dbcon *DB.DatabaseConnection, producer *sarama.SyncProducer) {
+	runs, err := dbcon.GetDueRuns(time.Now().UTC().Unix())
+	if err != nil {
+		log.Printf("Error loading due runs: %v", err)
+		return
+	}
+	for _, run := range runs {
+		wakeRun(ctx, dbcon, producer, run)
+	}
+}
+
+// wakeRun resumes a parked run if this server is the one to claim it.
+func wakeRun(ctx context.Context, dbcon *DB.DatabaseConnection, producer *sarama.SyncProducer, run DB.Run) {
+	acquired, err := DB.AcquireLease(ctx, runLeaseKey(run.ID), instanceID, runLeaseTTL)
+	if err != nil {
+		log.Printf("Error acquiring lease for run %s: %v", run.ID, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	claimed, err := dbcon.ClaimRun(run.ID)
+	if err != nil || !claimed {
+		if err != nil {
+			log.Printf("Error claiming run %s: %v", run.ID, err)
+		}
+		DB.ReleaseLease(ctx, runLeaseKey(run.ID))
+		return
+	}
+	resumeRun(ctx, dbcon, producer, run)
+}
+
+// WakeRun resumes a parked run right away, for instance once the approval it
+// waits on has been decided. Runs that are not parked are left alone.
+func WakeRun(dbcon *DB.DatabaseConnection, producer *sarama.SyncProducer, id string) error {
+	run, err := dbcon.GetRun(id)
+	if err != nil {
+		return err
+	}
+	if run.Status == DB.RunStatusWaiting {
+		wakeRun(context.Background(), dbcon, producer, run)
+	}
+	return nil
+}
+
+// CancelParkedRun cancels a run that is WAITING and reports whether it was.
+// The run's history ends with a CANCELLED record and its checkpoints and open
+// approvals are dropped, as for a run cancelled while it executes.
+func CancelParkedRun(dbcon *DB.DatabaseConnection, producer *sarama.SyncProducer, id string) (bool, error) {
+	cancelled, err := dbcon.CancelParkedRun(id)
+	if err != nil || !cancelled {
+		return cancelled, err
+	}
+	run, err := dbcon.GetRun(id)
+	if err != nil {
+		return true, err
+	}
+	workflow := proto.Workflow{Id: run.WorkflowID}
+	if err := json.Unmarshal(run.Workflow, &workflow); err != nil {
+		log.Printf("Error decoding workflow of run %s: %v", run.ID, err)
+	}
+	wp := &WorkflowProcessor{
+		ID:               run.ID,
+		Workflow:         &workflow,
+		ProcessVariables: make(map[string]value.Value),
+		DBcon:            *dbcon,
+		Producer:         producer,
+		Durable:          true,
+	}
+	if history, err := dbcon.GetReplayDataGroupedByProcessID(run.ID); err == nil && len(history) > 0 {
+		last := history[len(history)-1]
+		wp.Step = int32(last.ProcessSequence) + 1
+		if variables, err := RecordVariables(last); err == nil && variables != nil {
+			wp.ProcessVariables = variables
+		}
+	}
+	wp.UpdateRunStatus(proto.NodeStatus_CANCELLED, "Workflow cancelled while waiting")
+	wp.finishDurableRun(proto.NodeStatus_CANCELLED)
+	return true, nil
+}
@@ -0,0 +1,59 @@
+package workflow
+
+import (
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+)
+
+// maxSubprocessDepth bounds how deep subprocess calls may nest at run time,
+// so a call cycle that slipped past SubprocessCycle fails instead of
+// exhausting the stack.
+const maxSubprocessDepth = 32
+
+// SubprocessCycle returns the ids of a chain of workflows through which w ends
+// up calling itself or another workflow on the chain, starting and ending with
+// the same id, or nil when its subprocess calls contain no cycle. load fetches
+// the called workflows; w stands in for its own id so unsaved changes count.
+// Workflows that cannot be loaded are skipped.
+func SubprocessCycle(w *proto.Workflow, load func(id string) (*proto.Workflow, error)) []string {
+	done := make(map[string]bool)
+	var path []string
+	var visit func(id string, workflow *proto.Workflow) []string
+	visit = func(id string, workflow *proto.Workflow) []string {
+		for i, onPath := range path {
+			if onPath == id {
+				return append(append([]string(nil), path[i:]...), id)
+			}
+		}
+		if done[id] {
+			return nil
+		}
+		path = append(path, id)
+		for _, callee := range subprocessIds(workflow) {
+			next := w
+			if callee != w.Id {
+				var err error
+				if next, err = load(callee); err != nil || next == nil {
+					continue
+				}
+			}
+			if cycle := visit(callee, next); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		done[id] = true
+		return nil
+	}
+	return visit(w.Id, w)
+}
+
+// subprocessIds lists the workflows called by the subprocess nodes of w.
+func subprocessIds(w *proto.Workflow) []string {
+	ids := make([]string, 0)
+	for _, node := range w.Nodes {
+		if node.Nodetype == subprocessType && node.Data.GetSubProcessId() != "" {
+			ids = append(ids, node.Data.GetSubProcessId())
+		}
+	}
+	return ids
+}
@@ -0,0 +1,170 @@
+package workflow
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/raenardcruz/floowsynk/Server/value"
+)
+
+// templateFuncs are the functions every template can call, on top of the
+// text/template builtins such as index, printf and urlquery. Functions taking
+// the piped value take it last, so {{.csv | split ","}} works.
+var templateFuncs = map[string]interface{}{
+	"toJson":   toJSON,
+	"fromJson": fromJSON,
+	"default":  defaultValue,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"trim":     strings.TrimSpace,
+	"split":    split,
+	"join":     join,
+	"now":      time.Now,
+	"date":     date,
+	"b64enc":   b64enc,
+	"b64dec":   b64dec,
+	"sha256":   sha256Hex,
+	"get":      get,
+}
+
+func toJSON(v interface{}) (string, error) {
+	var builder strings.Builder
+	encoder := json.NewEncoder(&builder)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(builder.String(), "\n"), nil
+}
+
+func fromJSON(text string) (interface{}, error) {
+	v, err := value.FromJSON([]byte(text))
+	if err != nil {
+		return nil, err
+	}
+	return templateValue(v), nil
+}
+
+// defaultValue returns v, or def when v is empty: nil, false, zero, or an
+// empty string, list or map.
+func defaultValue(def, v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return def
+	case bool:
+		if !v {
+			return def
+		}
+	case float64:
+		if v == 0 {
+			return def
+		}
+	case string:
+		if v == "" {
+			return def
+		}
+	case templateList:
+		if len(v) == 0 {
+			return def
+		}
+	case templateMap:
+		if len(v) == 0 {
+			return def
+		}
+	}
+	return v
+}
+
+func split(sep, text string) templateList {
+	parts := strings.Split(text, sep)
+	list := make(templateList, len(parts))
+	for i, part := range parts {
+		list[i] = part
+	}
+	return list
+}
+
+func join(sep string, items templateList) string {
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = value.From(item).String()
+	}
+	return strings.Join(parts, sep)
+}
+
+// date formats t with a Go layout. t may be a time, RFC 3339 text or Unix
+// seconds.
+func date(layout string, t interface{}) (string, error) {
+	switch t := t.(type) {
+	case time.Time:
+		return t.Format(layout), nil
+	case float64:
+		sec, frac := math.Modf(t)
+		return time.Unix(int64(sec), int64(frac*1e9)).UTC().Format(layout), nil
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return "", fmt.Errorf("date: %v", err)
+		}
+		return parsed.Format(layout), nil
+	}
+	return "", fmt.Errorf("date: cannot format %T", t)
+}
+
+func b64enc(text string) string {
+	return base64.StdEncoding.EncodeToString([]byte(text))
+}
+
+func b64dec(text string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func sha256Hex(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// get follows a dotted path such as "items.0.price" into v and returns what it
+// finds, or nil when the path does not exist.
+func get(path string, v interface{}) interface{} {
+	if path == "" {
+		return v
+	}
+	for _, name := range strings.Split(path, ".") {
+		switch current := v.(type) {
+		case map[string]interface{}:
+			v = current[name]
+		case templateMap:
+			v = current[name]
+		case KeyValue:
+			switch name {
+			case "key", "Key":
+				v = current.Key
+			case "value", "Value":
+				v = current.Value
+			default:
+				return nil
+			}
+		case templateList:
+			i, err := strconv.Atoi(name)
+			if err != nil || i < 0 || i >= len(current) {
+				return nil
+			}
+			v = current[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
@@ -0,0 +1,77 @@
+package workflow
+
+import "testing"
+
+func TestTemplateFuncs(t *testing.T) {
+	variables := variablesOf(map[string]interface{}{
+		"name":  "  Ada <b>&</b> ",
+		"csv":   "a,b,c",
+		"items": []interface{}{1.0, "two", map[string]interface{}{"price": 9.5}},
+		"order": map[string]interface{}{"id": 7.0, "tags": []interface{}{"x"}},
+		"zero":  0.0,
+		"empty": "",
+		"stamp": 1700000000.0,
+		"iso":   "2024-01-02T03:04:05Z",
+		"json":  `{"a":[1,2],"b":"<x>"}`,
+	})
+	tests := []struct {
+		text     string
+		want     string
+		wantHTML string // when html mode renders differently from text mode
+	}{
+		{text: `{{toJson .items}}`, want: `[1,"two",{"price":9.5}]`},
+		{text: `{{toJson .name}}`, want: `"  Ada <b>&</b> "`},
+		{text: `{{(fromJson .json).a}}`, want: `[1,2]`},
+		{text: `{{get "b" (fromJson .json)}}`, want: `<x>`},
+		{text: `x{{fromJson "{"}}y`, want: `x`},
+		{text: `{{.empty | default "none"}}`, want: `none`},
+		{text: `{{.zero | default 5}}`, want: `5`},
+		{text: `{{.missing | default "none"}}`, want: `none`},
+		{text: `{{.name | default "none"}}`, want: `  Ada <b>&</b> `},
+		{text: `{{upper "abc"}}`, want: `ABC`},
+		{text: `{{lower "ÀBC"}}`, want: `àbc`},
+		{text: `[{{trim .name}}]`, want: `[Ada <b>&</b>]`},
+		{text: `{{.csv | split ","}}`, want: `["a","b","c"]`},
+		{text: `{{index (split "," .csv) 1}}`, want: `b`},
+		{text: `{{.csv | split "," | join "-"}}`, want: `a-b-c`},
+		{text: `{{join "+" .items}}`, want: `1+two+{"price":9.5}`},
+		{text: `{{date "2006-01-02" .stamp}}`, want: `2023-11-14`},
+		{text: `{{date "Jan 2 15:04" .iso}}`, want: `Jan 2 03:04`},
+		{text: `x{{date "2006" .items}}y`, want: `x`},
+		{text: `{{if now}}ok{{end}}`, want: `ok`},
+		{text: `{{b64enc "hi there"}}`, want: `aGkgdGhlcmU=`},
+		{text: `{{b64dec "aGkgdGhlcmU="}}`, want: `hi there`},
+		{text: `x{{b64dec "%%"}}y`, want: `x`},
+		{text: `{{sha256 "abc"}}`, want: `ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad`},
+		{text: `{{get "items.2.price" .}}`, want: `9.5`},
+		{text: `{{get "order.tags.0" .}}`, want: `x`},
+		{text: `{{get "items.9" . | default "none"}}`, want: `none`},
+		{text: `{{get "order.id.x" . | default "none"}}`, want: `none`},
+		{text: `{{get "" .zero}}`, want: `0`},
+		{text: `{{.name}}`, want: `  Ada <b>&</b> `},
+		{
+			text:     `<a href="{{.name}}">`,
+			want:     `<a href="  Ada <b>&</b> ">`,
+			wantHTML: `<a href="%20%20Ada%20%3cb%3e&%3c/b%3e%20">`,
+		},
+		{
+			text:     `<script>var n = {{.name}};</script>`,
+			want:     `<script>var n =   Ada <b>&</b> ;</script>`,
+			wantHTML: `<script>var n = "  Ada \u003cb\u003e\u0026\u003c/b\u003e ";</script>`,
+		},
+	}
+	wp := &WorkflowProcessor{ID: t.Name()}
+	data := templateData(variables)
+	for _, test := range tests {
+		if got := wp.executeWith(test.text, data, true); got != test.want {
+			t.Errorf("text %s = %q, want %q", test.text, got, test.want)
+		}
+		want := test.want
+		if test.wantHTML != "" {
+			want = test.wantHTML
+		}
+		if got := wp.executeWith(test.text, data, false); got != want {
+			t.Errorf("html %s = %q, want %q", test.text, got, want)
+		}
+	}
+}
@@ -1,9 +1,17 @@
 package workflow
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/IBM/sarama"
 	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
 	db "github.com/raenardcruz/floowsynk/Database"
+	m "github.com/raenardcruz/floowsynk/Server/matheval"
+	"github.com/raenardcruz/floowsynk/Server/value"
 	"google.golang.org/grpc"
 )
 
@@ -11,6 +19,8 @@ type KeyValue struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 }
+type nodeProcessor func(context.Context, *proto.Node) (string, error)
+
 type GrpcWorkflowStream interface {
 	Send(*proto.ReplayData) error
 	grpc.ServerStream
@@ -20,10 +30,84 @@ type WorkflowProcessor struct {
 	ID               string
 	Stream           GrpcWorkflowStream
 	Workflow         *proto.Workflow
-	ProcessVariables map[string]interface{}
+	ProcessVariables map[string]value.Value
 	DBcon            db.DatabaseConnection
 	Producer         *sarama.SyncProducer
 	Step             int32
+	Durable          bool         // checkpoint every node so the run can resume after a restart
+	ParentID         string       // run this one was resumed from
+	StartNodeID      string       // node the run starts at, the trigger when empty
+	Debug            bool         // pause at Breakpoints and accept DebugControl commands
+	Breakpoints      []string     // node ids to pause before, qualified like history for subprocess steps
+	PauseAtStart     bool         // pause before the first node
+	StartedBy        string       // id of the user who started the run, empty for triggers
+	Functions        *m.Functions // functions the run's expressions can call besides the built-in ones
+
+	varsMu sync.RWMutex // guards ProcessVariables across parallel branches
+	emitMu sync.Mutex   // keeps Step and ReplayData emission in sequence
+	joinMu sync.Mutex   // guards joins
+	joins  map[string]*joinState
+
+	root       *WorkflowProcessor     // processor that owns Step, emission and debugging, for scopes and subprocesses
+	branchOf   *WorkflowProcessor     // processor that owns the joins and journal, for parallel branches
+	passedJoin bool                   // whether this branch fired a join and ran on past it
+	forkBase   map[string]value.Value // variables before the outermost fan-out, for parallel branches
+	nodePrefix string                 // prepended to node ids in history, for subprocess steps
+	depth      int                    // number of subprocess calls this processor is nested in
+	debug      *debugSession          // set on the root of a debug run
+	compiled   compileCache           // templates and expressions compiled by the run, on the root
+
+	journalMu   sync.Mutex // guards occurrences and journal
+	occurrences map[string]int
+	journal     map[string]db.RunCheckpoint // checkpoints being replayed, by checkpointKey
+	lastSaved   []byte                      // variables of the last checkpoint this processor saved
+}
+
+// joinState tracks the branches that reached a join node since it last fired.
+type joinState struct {
+	arrivals []joinArrival
+	fired    bool
+	ready    [][]joinArrival // merged arrivals waiting for JoinNodeProcess
+}
+
+type joinArrival struct {
+	Source    string
+	Output    value.Value
+	Variables map[string]value.Value // variables the branch set before it arrived
+}
+
+// HTTPStatusError is returned by API calls that receive a 4xx or 5xx response
+// the node's retry policy covers.
+type HTTPStatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("request failed with status %s", e.Status)
+}
+
+// errBreak and errContinue unwind the branch that reached a break or continue
+// node back to the nearest enclosing loop.
+var (
+	errBreak    = errors.New("break used outside a loop")
+	errContinue = errors.New("continue used outside a loop")
+)
+
+// RunParkedError unwinds a durable run that waits for WakeAt, or for an
+// outside event when WakeAt is zero. When Done is set, node NodeID counts as
+// completed once the run wakes.
+type RunParkedError struct {
+	NodeID string
+	WakeAt time.Time
+	Done   bool
+}
+
+func (e *RunParkedError) Error() string {
+	if e.WakeAt.IsZero() {
+		return fmt.Sprintf("run parked at node %s", e.NodeID)
+	}
+	return fmt.Sprintf("run parked at node %s until %s", e.NodeID, e.WakeAt.Format(time.RFC3339))
 }
 
 type WorkflowHistory struct {
@@ -0,0 +1,379 @@
+package workflow
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Severities of a ValidationIssue. Errors stop a workflow from being saved or
+// run; warnings are only reported.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// triggerTypes are the node types a run starts at.
+var triggerTypes = map[string]bool{
+	defaultnodeType: true,
+	intervalType:    true,
+	webhookType:     true,
+	eventsType:      true,
+}
+
+// requiredFields lists, per node type, the NodeData fields its processor reads
+// and cannot run without. An empty string field is only a warning, so drafts
+// can be saved, except for the ones in mayBeEmpty where it is expected.
+var requiredFields = map[string][]string{
+	setVariabletype: {"name", "value"},
+	textType:        {"message", "variable"},
+	conditionType:   {"expression"},
+	listType:        {"list", "variable"},
+	loopType:        {"iteration"},
+	forEachType:     {"listvar"},
+	whileType:       {"expression", "limit"},
+	apiType:         {"url", "method", "headers", "payload", "variable"},
+	logType:         {"message"},
+	guidType:        {"variable"},
+	mathType:        {"expression", "variable"},
+	countType:       {"listVariable", "variable"},
+	mapType:         {"listVariable", "variable", "template"},
+	replaceType:     {"text", "pattern", "replaceText", "variable"},
+	findAllType:     {"text", "pattern", "variable"},
+	subprocessType:  {"subProcessId"},
+	switchType:      {"expression"},
+}
+
+var mayBeEmpty = map[string]bool{
+	"value":       true,
+	"payload":     true,
+	"replaceText": true,
+}
+
+// branchTypes are the node types that can leave a cycle of edges, which makes
+// such a cycle a deliberate loop rather than an endless one.
+var branchTypes = map[string]bool{
+	conditionType: true,
+	switchType:    true,
+	approvalType:  true,
+}
+
+// Validate checks the graph of w and returns every problem found, ordered by
+// check. A workflow is valid when none of the issues has SeverityError.
+func Validate(w *proto.Workflow) []*proto.ValidationIssue {
+	issues := make([]*proto.ValidationIssue, 0)
+	report := func(severity, nodeId, edgeId, field, format string, args ...interface{}) {
+		issues = append(issues, &proto.ValidationIssue{
+			NodeId:   nodeId,
+			EdgeId:   edgeId,
+			Field:    field,
+			Severity: severity,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	nodes := make(map[string]*proto.Node, len(w.Nodes))
+	triggers := make([]*proto.Node, 0, 1)
+	known := (&WorkflowProcessor{}).nodeProcessors()
+	for _, node := range w.Nodes {
+		if _, ok := nodes[node.Id]; ok {
+			report(SeverityError, node.Id, "", "", "Node id %s is used by more than one node", node.Id)
+			continue
+		}
+		nodes[node.Id] = node
+		if triggerTypes[node.Nodetype] {
+			triggers = append(triggers, node)
+		}
+		if _, ok := known[node.Nodetype]; !ok {
+			report(SeverityError, node.Id, "", "", "Unknown node type %q", node.Nodetype)
+			continue
+		}
+		missing, empty := checkFields(node)
+		for _, field := range missing {
+			report(SeverityError, node.Id, "", field, "%s node requires %s", node.Nodetype, field)
+		}
+		for _, field := range empty {
+			report(SeverityWarning, node.Id, "", field, "%s node has an empty %s", node.Nodetype, field)
+		}
+		if node.Nodetype == findAllType || node.Nodetype == replaceType {
+			if pattern := node.Data.GetPattern(); !strings.Contains(pattern, "{{") {
+				if _, err := regexp.Compile(pattern); err != nil {
+					report(SeverityError, node.Id, "", "pattern", "Invalid pattern: %v", err)
+				}
+			}
+		}
+	}
+	switch {
+	case len(triggers) == 0:
+		report(SeverityError, "", "", "", "Workflow has no trigger node")
+	case len(triggers) > 1:
+		for _, trigger := range triggers[1:] {
+			report(SeverityError, trigger.Id, "", "", "Workflow already has trigger node %s", triggers[0].Id)
+		}
+	}
+
+	next := make(map[string][]string, len(nodes))
+	outgoing := make(map[string][]*proto.Edge, len(nodes))
+	for _, edge := range w.Edges {
+		if _, ok := nodes[edge.Source]; !ok {
+			report(SeverityError, "", edge.Id, "", "Edge starts at missing node %s", edge.Source)
+			continue
+		}
+		if _, ok := nodes[edge.Target]; !ok {
+			report(SeverityError, edge.Source, edge.Id, "", "Edge ends at missing node %s", edge.Target)
+			continue
+		}
+		if triggerTypes[nodes[edge.Target].Nodetype] {
+			report(SeverityError, edge.Source, edge.Id, "", "Edge leads into trigger node %s", edge.Target)
+		}
+		source := nodes[edge.Source]
+		if handles := sourceHandles(source); handles != nil && edge.Sourcehandle != ERROR && !handles[edge.Sourcehandle] {
+			report(SeverityWarning, edge.Source, edge.Id, "", "Edge leaves %s node from unknown handle %q and is never followed", source.Nodetype, edge.Sourcehandle)
+		}
+		next[edge.Source] = append(next[edge.Source], edge.Target)
+		outgoing[edge.Source] = append(outgoing[edge.Source], edge)
+	}
+
+	if len(triggers) > 0 {
+		reached := map[string]bool{triggers[0].Id: true}
+		queue := []string{triggers[0].Id}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for _, target := range next[id] {
+				if !reached[target] {
+					reached[target] = true
+					queue = append(queue, target)
+				}
+			}
+		}
+		for _, node := range w.Nodes {
+			if !reached[node.Id] && !triggerTypes[node.Nodetype] {
+				report(SeverityWarning, node.Id, "", "", "Node is not reachable from the trigger")
+			}
+		}
+	}
+
+	for _, node := range w.Nodes {
+		if !node.Data.GetParallel() {
+			continue
+		}
+		for _, id := range missingJoins(w.Nodes, node, outgoing[node.Id], next) {
+			report(SeverityWarning, id, "", "", "Parallel branches of %s meet here without a join node; this node runs once per branch", node.Id)
+		}
+	}
+
+	for _, cycle := range cycles(w.Nodes, next) {
+		exits := false
+		for _, id := range cycle {
+			exits = exits || branchTypes[nodes[id].Nodetype]
+		}
+		if exits {
+			report(SeverityWarning, cycle[0], "", "", "Nodes %s form a cycle; prefer a loop node", strings.Join(cycle, ", "))
+		} else {
+			report(SeverityError, cycle[0], "", "", "Nodes %s form a cycle with no condition to leave it", strings.Join(cycle, ", "))
+		}
+	}
+	return issues
+}
+
+// ValidationError summarises the errors among issues, or returns nil when
+// there are none.
+func ValidationError(issues []*proto.ValidationIssue) error {
+	messages := make([]string, 0)
+	for _, issue := range issues {
+		if issue.Severity != SeverityError {
+			continue
+		}
+		switch {
+		case issue.NodeId != "":
+			messages = append(messages, fmt.Sprintf("node %s: %s", issue.NodeId, issue.Message))
+		case issue.EdgeId != "":
+			messages = append(messages, fmt.Sprintf("edge %s: %s", issue.EdgeId, issue.Message))
+		default:
+			messages = append(messages, issue.Message)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid workflow: %s", strings.Join(messages, "; "))
+}
+
+// checkFields returns the required fields of node that are not set, and the
+// string ones that are set but empty.
+func checkFields(node *proto.Node) (missing, empty []string) {
+	fields := requiredFields[node.Nodetype]
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	if node.Data == nil {
+		return fields, nil
+	}
+	data := node.Data.ProtoReflect()
+	descriptors := data.Descriptor().Fields()
+	for _, name := range fields {
+		fd := descriptors.ByName(protoreflect.Name(name))
+		switch {
+		case !data.Has(fd):
+			missing = append(missing, name)
+		case fd.Kind() == protoreflect.StringKind && !mayBeEmpty[name] && data.Get(fd).String() == "":
+			empty = append(empty, name)
+		}
+	}
+	return missing, empty
+}
+
+// sourceHandles returns the handles node can leave from, for node types that
+// choose which of their edges to follow, or nil when every edge is followed.
+func sourceHandles(node *proto.Node) map[string]bool {
+	switch node.Nodetype {
+	case conditionType:
+		return map[string]bool{TRUE: true, FALSE: true}
+	case loopType, forEachType, whileType:
+		// Edges drawn before loops had body and done handles are the body.
+		return map[string]bool{BODY: true, DONE: true, OUTPUT: true, "": true}
+	case approvalType:
+		return map[string]bool{APPROVED: true, REJECTED: true, TIMEOUT: true}
+	case switchType:
+		handles := map[string]bool{DEFAULT: true}
+		for _, c := range node.Data.GetCases().GetStringItems() {
+			handles[c] = true
+		}
+		return handles
+	}
+	return nil
+}
+
+// missingJoins returns the nodes, in workflow order, where branches that fork
+// runs in parallel meet without a join node in between. Only the first node
+// where they meet is returned, not the ones after it.
+func missingJoins(nodes []*proto.Node, fork *proto.Node, edges []*proto.Edge, next map[string][]string) []string {
+	byHandle := make(map[string][]string)
+	for _, edge := range edges {
+		if edge.Sourcehandle == ERROR {
+			continue
+		}
+		handle := edge.Sourcehandle
+		if handles := sourceHandles(fork); handles == nil || (handles[BODY] && isLegacyBodyHandle(handle)) {
+			handle = ""
+		}
+		byHandle[handle] = append(byHandle[handle], edge.Target)
+	}
+	joinTypes := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		joinTypes[node.Id] = node.Nodetype == joinType
+	}
+
+	met := make(map[string]bool)
+	for _, targets := range byHandle {
+		if len(targets) < 2 {
+			continue
+		}
+		branches := make(map[string]int)
+		for _, target := range targets {
+			reached := map[string]bool{fork.Id: true}
+			queue := []string{target}
+			for len(queue) > 0 {
+				id := queue[0]
+				queue = queue[1:]
+				if reached[id] || joinTypes[id] {
+					continue
+				}
+				reached[id] = true
+				branches[id]++
+				queue = append(queue, next[id]...)
+			}
+		}
+		for id, count := range branches {
+			if count < 2 {
+				continue
+			}
+			first := true
+			for source, targets := range next {
+				for _, target := range targets {
+					if target == id && source != id && branches[source] >= 2 {
+						first = false
+					}
+				}
+			}
+			met[id] = met[id] || first
+		}
+	}
+	found := make([]string, 0)
+	for _, node := range nodes {
+		if met[node.Id] {
+			found = append(found, node.Id)
+		}
+	}
+	return found
+}
+
+// cycles returns the node ids of every group of nodes that can reach each
+// other through edges, found with Tarjan's algorithm, in workflow order.
+func cycles(nodes []*proto.Node, next map[string][]string) [][]string {
+	index := make(map[string]int)
+	low := make(map[string]int)
+	onStack := make(map[string]bool)
+	stack := make([]string, 0)
+	found := make([][]string, 0)
+	var visit func(id string)
+	visit = func(id string) {
+		index[id] = len(index)
+		low[id] = index[id]
+		stack = append(stack, id)
+		onStack[id] = true
+		selfLoop := false
+		for _, target := range next[id] {
+			if target == id {
+				selfLoop = true
+			}
+			if _, seen := index[target]; !seen {
+				visit(target)
+				low[id] = min(low[id], low[target])
+			} else if onStack[target] {
+				low[id] = min(low[id], index[target])
+			}
+		}
+		if low[id] != index[id] {
+			return
+		}
+		group := make([]string, 0)
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			group = append(group, top)
+			if top == id {
+				break
+			}
+		}
+		if len(group) > 1 || selfLoop {
+			found = append(found, inNodeOrder(nodes, group))
+		}
+	}
+	for _, node := range nodes {
+		if _, seen := index[node.Id]; !seen {
+			visit(node.Id)
+		}
+	}
+	return found
+}
+
+func inNodeOrder(nodes []*proto.Node, ids []string) []string {
+	members := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		members[id] = true
+	}
+	ordered := make([]string, 0, len(ids))
+	for _, node := range nodes {
+		if members[node.Id] {
+			ordered = append(ordered, node.Id)
+			delete(members, node.Id)
+		}
+	}
+	return ordered
+}
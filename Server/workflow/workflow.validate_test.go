@@ -0,0 +1,172 @@
+package workflow
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	proto "github.com/raenardcruz/floowsynk/CodeGen/go/workflow"
+)
+
+func TestValidate(t *testing.T) {
+	trigger := &proto.Node{Id: "0", Nodetype: "defaultnode"}
+	text := func(id string) *proto.Node {
+		return &proto.Node{Id: id, Nodetype: "text", Data: &proto.NodeData{Message: ptr(id), Variable: ptr(id)}}
+	}
+	typed := func(id, nodetype string, data *proto.NodeData) *proto.Node {
+		return &proto.Node{Id: id, Nodetype: nodetype, Data: data}
+	}
+	edge := func(id, source, target, handle string) *proto.Edge {
+		return &proto.Edge{Id: id, Source: source, Target: target, Sourcehandle: handle}
+	}
+	condition := typed("c", "condition", &proto.NodeData{Expression: ptr("x > 1")})
+	parallel := typed("p", "text", &proto.NodeData{Message: ptr("p"), Variable: ptr("p"), Parallel: ptr(true)})
+
+	tests := []struct {
+		name  string
+		nodes []*proto.Node
+		edges []*proto.Edge
+		want  []string
+	}{
+		{
+			name:  "valid",
+			nodes: []*proto.Node{trigger, text("a"), text("b")},
+			edges: []*proto.Edge{edge("e1", "0", "a", ""), edge("e2", "a", "b", "output")},
+		},
+		{
+			name:  "no trigger",
+			nodes: []*proto.Node{text("a")},
+			want:  []string{`error [||] Workflow has no trigger node`},
+		},
+		{
+			name:  "two triggers",
+			nodes: []*proto.Node{trigger, typed("w", "webhook", nil)},
+			want:  []string{`error [w||] Workflow already has trigger node 0`},
+		},
+		{
+			name:  "duplicate id and unknown type",
+			nodes: []*proto.Node{trigger, text("a"), text("a"), typed("x", "teleport", nil)},
+			edges: []*proto.Edge{edge("e1", "0", "a", ""), edge("e2", "a", "x", "")},
+			want: []string{
+				`error [a||] Node id a is used by more than one node`,
+				`error [x||] Unknown node type "teleport"`,
+			},
+		},
+		{
+			name:  "required fields",
+			nodes: []*proto.Node{trigger, typed("a", "text", &proto.NodeData{Message: ptr("")}), typed("s", "setVariable", &proto.NodeData{Name: ptr("n"), Value: ptr("")})},
+			edges: []*proto.Edge{edge("e1", "0", "a", ""), edge("e2", "a", "s", "")},
+			want: []string{
+				`error [a||variable] text node requires variable`,
+				`warning [a||message] text node has an empty message`,
+			},
+		},
+		{
+			name:  "dangling edges",
+			nodes: []*proto.Node{trigger, text("a")},
+			edges: []*proto.Edge{edge("e1", "0", "a", ""), edge("e2", "gone", "a", ""), edge("e3", "a", "gone", ""), edge("e4", "a", "0", "")},
+			want: []string{
+				`error [|e2|] Edge starts at missing node gone`,
+				`error [a|e3|] Edge ends at missing node gone`,
+				`error [a|e4|] Edge leads into trigger node 0`,
+				`error [0||] Nodes 0, a form a cycle with no condition to leave it`,
+			},
+		},
+		{
+			name:  "unreachable",
+			nodes: []*proto.Node{trigger, text("a"), text("b")},
+			edges: []*proto.Edge{edge("e1", "a", "b", "")},
+			want: []string{
+				`warning [a||] Node is not reachable from the trigger`,
+				`warning [b||] Node is not reachable from the trigger`,
+			},
+		},
+		{
+			name:  "cycle without a way out",
+			nodes: []*proto.Node{trigger, text("a"), text("b"), text("d")},
+			edges: []*proto.Edge{edge("e1", "0", "a", ""), edge("e2", "a", "b", ""), edge("e3", "b", "a", ""), edge("e4", "b", "d", "")},
+			want:  []string{`error [a||] Nodes a, b form a cycle with no condition to leave it`},
+		},
+		{
+			name:  "self loop",
+			nodes: []*proto.Node{trigger, text("a")},
+			edges: []*proto.Edge{edge("e1", "0", "a", ""), edge("e2", "a", "a", "")},
+			want:  []string{`error [a||] Nodes a form a cycle with no condition to leave it`},
+		},
+		{
+			name:  "cycle through a condition",
+			nodes: []*proto.Node{trigger, text("a"), condition, text("d")},
+			edges: []*proto.Edge{edge("e1", "0", "a", ""), edge("e2", "a", "c", ""), edge("e3", "c", "a", TRUE), edge("e4", "c", "d", FALSE)},
+			want:  []string{`warning [a||] Nodes a, c form a cycle; prefer a loop node`},
+		},
+		{
+			name:  "separate cycles in workflow order",
+			nodes: []*proto.Node{trigger, text("a"), text("b"), text("d"), text("f")},
+			edges: []*proto.Edge{edge("e1", "0", "f", ""), edge("e2", "f", "d", ""), edge("e3", "d", "f", ""), edge("e4", "f", "a", ""), edge("e5", "a", "b", ""), edge("e6", "b", "a", "")},
+			want: []string{
+				`error [a||] Nodes a, b form a cycle with no condition to leave it`,
+				`error [d||] Nodes d, f form a cycle with no condition to leave it`,
+			},
+		},
+		{
+			name: "handles",
+			nodes: []*proto.Node{
+				trigger, condition, text("a"),
+				typed("l", "loop", &proto.NodeData{Iteration: ptr(int32(2))}),
+				typed("s", "switch", &proto.NodeData{Expression: ptr("{{.x}}"), Cases: &proto.NodeDataArray{StringItems: []string{"red"}}}),
+			},
+			edges: []*proto.Edge{
+				edge("e1", "0", "c", ""),
+				edge("e2", "c", "a", TRUE),
+				edge("e3", "c", "a", "output"),
+				edge("e4", "c", "a", ERROR),
+				edge("e5", "0", "l", ""),
+				edge("e6", "l", "a", "output"),
+				edge("e7", "l", "a", DONE),
+				edge("e8", "l", "a", "True"),
+				edge("e9", "0", "s", ""),
+				edge("e10", "s", "a", "red"),
+				edge("e11", "s", "a", DEFAULT),
+				edge("e12", "s", "a", "blue"),
+				edge("e13", "a", "0", ""),
+			},
+			want: []string{
+				`warning [c|e3|] Edge leaves condition node from unknown handle "output" and is never followed`,
+				`warning [l|e8|] Edge leaves loop node from unknown handle "True" and is never followed`,
+				`warning [s|e12|] Edge leaves switch node from unknown handle "blue" and is never followed`,
+				`error [a|e13|] Edge leads into trigger node 0`,
+				`warning [0||] Nodes 0, c, a, l, s form a cycle; prefer a loop node`,
+			},
+		},
+		{
+			name:  "parallel branches meet without a join",
+			nodes: []*proto.Node{trigger, parallel, text("a"), text("b"), text("m"), text("n")},
+			edges: []*proto.Edge{edge("e1", "0", "p", ""), edge("e2", "p", "a", ""), edge("e3", "p", "b", ""), edge("e4", "a", "m", ""), edge("e5", "b", "m", ""), edge("e6", "m", "n", "")},
+			want:  []string{`warning [m||] Parallel branches of p meet here without a join node; this node runs once per branch`},
+		},
+		{
+			name:  "parallel branches meet at a join",
+			nodes: []*proto.Node{trigger, parallel, text("a"), text("b"), typed("j", "join", nil), text("n")},
+			edges: []*proto.Edge{edge("e1", "0", "p", ""), edge("e2", "p", "a", ""), edge("e3", "p", "b", ""), edge("e4", "a", "j", ""), edge("e5", "b", "j", ""), edge("e6", "j", "n", "")},
+		},
+		{
+			name:  "sequential branches meet",
+			nodes: []*proto.Node{trigger, text("p"), text("a"), text("b"), text("m")},
+			edges: []*proto.Edge{edge("e1", "0", "p", ""), edge("e2", "p", "a", ""), edge("e3", "p", "b", ""), edge("e4", "a", "m", ""), edge("e5", "b", "m", "")},
+		},
+	}
+	for _, test := range tests {
+		issues := Validate(&proto.Workflow{Nodes: test.nodes, Edges: test.edges})
+		got := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			got = append(got, fmt.Sprintf("%s [%s|%s|%s] %s", issue.Severity, issue.NodeId, issue.EdgeId, issue.Field, issue.Message))
+		}
+		want := test.want
+		if want == nil {
+			want = []string{}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s:\n got %q\nwant %q", test.name, got, want)
+		}
+	}
+}